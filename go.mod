@@ -1,5 +1,8 @@
 module github.com/adrianolaselva/solr-client-go
 
-require github.com/google/go-querystring v1.0.0
+require (
+	github.com/google/go-querystring v1.0.0
+	gopkg.in/yaml.v2 v2.4.0
+)
 
 go 1.13
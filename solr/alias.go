@@ -0,0 +1,180 @@
+// https://lucene.apache.org/solr/guide/8_5/alias-management.html
+package solr
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+)
+
+// The properties of an alias. An empty value removes the property when sent with ALIASPROP.
+type AliasProperties map[string]string
+
+// The properties of each alias, returned by LISTALIASES.
+type AliasesProperties map[string]AliasProperties
+
+type CollectionCreateAlias struct {
+	collectionBase
+
+	// The alias name to be created. This parameter is required. If the alias is to be routed it
+	// also functions as a prefix for the names of the dependent collections that will be created.
+	Name string `url:"name,omitempty"`
+
+	// A comma-separated list of collections to be aliased. The collections must already exist in
+	// the cluster. This parameter signals the creation of a standard alias.
+	Collections []string `url:"collections,comma,omitempty"`
+
+	// The router of a routed alias, sent as router.<param>. Collections must be empty for a
+	// routed alias, its collections are created as documents are routed to them.
+	Router *AliasRouter `url:"router,omitempty"`
+
+	// The time zone used by the date math of a time routed alias, e.g. America/New_York. The
+	// default is UTC.
+	TZ string `url:"TZ,omitempty"`
+
+	// The parameters of the collections created by a routed alias, sent as create-collection.<param>.
+	// The collection configuration and the number of shards are usually required.
+	CreateCollection *CreateCollectionParameters `url:"create-collection,omitempty"`
+
+	// Request ID to track this action which will be processed asynchronously.
+	Async string `url:"async,omitempty"`
+}
+
+type CollectionListAliases struct {
+	collectionBase
+}
+
+type CollectionDeleteAlias struct {
+	collectionBase
+
+	// The name of the alias to delete. This parameter is required.
+	Name string `url:"name,omitempty"`
+
+	// Request ID to track this action which will be processed asynchronously.
+	Async string `url:"async,omitempty"`
+}
+
+type CollectionAliasProp struct {
+	collectionBase
+
+	// The alias name on which to set properties. This parameter is required.
+	Name string `url:"name,omitempty"`
+
+	// The properties to set, sent as property.<name>=<value>. An empty value removes the property.
+	Properties AliasProperties `url:"property,omitempty"`
+
+	// Request ID to track this action which will be processed asynchronously.
+	Async string `url:"async,omitempty"`
+}
+
+// EncodeValues encodes the properties as property.<name>=<value>, sorted by name.
+func (p AliasProperties) EncodeValues(key string, v *url.Values) error {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		v.Add(key+"."+name, p[name])
+	}
+
+	return nil
+}
+
+// CreateAlias: Create or Modify an Alias for a Collection
+// Creates an alias pointing to one or more collections, an existing alias is atomically
+// replaced.
+func (c *CollectionAPI) CreateAlias(ctx context.Context, collection CollectionCreateAlias) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = CreateAliasAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// ListAliases: List of all aliases in the cluster
+// The aliases are returned in Response.Aliases and their properties in Response.Properties.
+func (c *CollectionAPI) ListAliases(ctx context.Context) (*Response, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, CollectionListAliases{
+		collectionBase: collectionBase{Action: ListAliasesAction, WT: JSON},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// DeleteAlias: Delete a Collection Alias
+func (c *CollectionAPI) DeleteAlias(ctx context.Context, collection CollectionDeleteAlias) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = DeleteAliasAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// AliasProp: Modify Alias Properties for a Collection
+// Sets or removes properties of an alias.
+func (c *CollectionAPI) AliasProp(ctx context.Context, collection CollectionAliasProp) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = AliasPropAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// SwapAlias: Point an Alias to another Collection
+// The collection is checked with ClusterState.CheckCollection first, the alias is only replaced
+// when every active shard is served by active replicas on live nodes.
+func (c *CollectionAPI) SwapAlias(ctx context.Context, alias string, collection string) (*Response, error) {
+	response, err := c.ClusterStatus(ctx, CollectionClusterStatus{Collection: collection})
+	if err != nil {
+		return nil, err
+	}
+	if err := response.Err(); err != nil {
+		return response, err
+	}
+	if err := response.Cluster.CheckCollection(collection); err != nil {
+		return response, err
+	}
+
+	return c.CreateAlias(ctx, CollectionCreateAlias{
+		Name:        alias,
+		Collections: []string{collection},
+	})
+}
@@ -0,0 +1,96 @@
+package solr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestAliasPropParameters(t *testing.T) {
+	values, err := query.Values(CollectionAliasProp{
+		collectionBase: collectionBase{Action: AliasPropAction},
+		Name:           "events",
+		Properties:     AliasProperties{"owner": "search", "stale": ""},
+	})
+	if err != nil {
+		t.Errorf("failed to encode alias properties %v", err)
+	}
+
+	if values.Encode() != "action=ALIASPROP&name=events&property.owner=search&property.stale=" {
+		t.Errorf("failed to encode alias properties %v", values.Encode())
+	}
+
+	values, err = query.Values(CollectionCreateAlias{Name: "events", Collections: []string{"events_a", "events_b"}})
+	if err != nil {
+		t.Errorf("failed to encode alias collections %v", err)
+	}
+	if values.Encode() != "collections=events_a%2Cevents_b&name=events" {
+		t.Errorf("failed to encode alias collections %v", values.Encode())
+	}
+}
+
+func TestListAliasesDecode(t *testing.T) {
+	b := []byte(`{
+		"responseHeader": {"status": 0, "QTime": 1},
+		"aliases": {"events": "events_b", "all": "events_a,events_b"},
+		"properties": {"events": {"owner": "search"}}
+	}`)
+
+	var response Response
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatalf("failed to decode aliases %v", err)
+	}
+
+	if !reflect.DeepEqual(response.Aliases, map[string]string{"events": "events_b", "all": "events_a,events_b"}) {
+		t.Errorf("failed to decode aliases %v", response.Aliases)
+	}
+	if response.Properties["events"]["owner"] != "search" {
+		t.Errorf("failed to decode alias properties %v", response.Properties)
+	}
+}
+
+func TestSwapAlias(t *testing.T) {
+	var actions []string
+	state := testClusterState()
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+		actions = append(actions, params.Get("action"))
+
+		switch params.Get("action") {
+		case "CLUSTERSTATUS":
+			writeClusterStatus(w, state)
+		case "CREATEALIAS":
+			if params.Get("name") != "current" || params.Get("collections") != "events" {
+				t.Errorf("failed to point the alias to the collection %v", r.URL)
+			}
+			_, _ = w.Write([]byte(`{"responseHeader": {"status": 0}}`))
+		}
+	})
+	defer server.Close()
+
+	_, err := client.Collection.SwapAlias(context.Background(), "current", "events")
+	if err != nil {
+		t.Fatalf("failed to swap alias %v", err)
+	}
+	if !reflect.DeepEqual(actions, []string{"CLUSTERSTATUS", "CREATEALIAS"}) {
+		t.Errorf("failed to swap alias %v", actions)
+	}
+
+	actions = nil
+	state.Collections["events"].Shards["shard1"].Replicas["core_node2"] = Replica{NodeName: "b:8983_solr", State: ReplicaRecovering}
+	_, err = client.Collection.SwapAlias(context.Background(), "current", "events")
+	unhealthy, ok := err.(*UnhealthyCollectionError)
+	if !ok {
+		t.Fatalf("failed to refuse swapping to an unhealthy collection %v", err)
+	}
+	if !reflect.DeepEqual(unhealthy.Problems, []string{"shard1/core_node2: replica is recovering"}) {
+		t.Errorf("failed to report the recovering replica %v", unhealthy.Problems)
+	}
+	if !reflect.DeepEqual(actions, []string{"CLUSTERSTATUS"}) {
+		t.Errorf("failed to stop before swapping alias %v", actions)
+	}
+}
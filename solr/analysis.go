@@ -0,0 +1,257 @@
+// https://lucene.apache.org/solr/guide/8_5/implicit-requesthandlers.html#analysis-handlers
+package solr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+type analysisBase struct {
+	WT WT `url:"wt,omitempty"`
+}
+
+type FieldAnalysisRequest struct {
+	analysisBase
+
+	// The names of the fields whose analyzers are used. Either FieldName or FieldType is required.
+	FieldName []string `url:"analysis.fieldname,comma,omitempty"`
+
+	// The names of the field types whose analyzers are used.
+	FieldType []string `url:"analysis.fieldtype,comma,omitempty"`
+
+	// The text analyzed by the index analyzer.
+	FieldValue string `url:"analysis.fieldvalue,omitempty"`
+
+	// The text analyzed by the query analyzer.
+	Query string `url:"analysis.query,omitempty"`
+
+	// If true, the tokens of the index analysis matching a query token are flagged with match.
+	ShowMatch bool `url:"analysis.showmatch,omitempty"`
+}
+
+type DocumentAnalysisRequest struct {
+	analysisBase
+
+	// The text analyzed by the query analyzer of every field of the documents.
+	Query string `url:"analysis.query,omitempty"`
+
+	// If true, the tokens of the index analysis matching a query token are flagged with match.
+	ShowMatch bool `url:"analysis.showmatch,omitempty"`
+}
+
+type AnalysisAPI struct {
+	client *Client
+}
+
+// The output of an analysis request, FieldTypes and FieldNames are populated by the field
+// analysis handler while Documents is populated by the document analysis handler, indexed
+// by unique key and field name.
+type Analysis struct {
+	FieldTypes map[string]FieldAnalysis
+	FieldNames map[string]FieldAnalysis
+	Documents  map[string]map[string]DocumentFieldAnalysis
+}
+
+type FieldAnalysis struct {
+	Index AnalysisStages `json:"index,omitempty"`
+	Query AnalysisStages `json:"query,omitempty"`
+}
+
+type DocumentFieldAnalysis struct {
+	// Index analysis of each value of the field.
+	Index map[string]AnalysisStages `json:"index,omitempty"`
+	Query AnalysisStages            `json:"query,omitempty"`
+}
+
+// The stages of an analyzer, in order: char filters, the tokenizer and each token filter.
+type AnalysisStages []AnalysisStage
+
+type AnalysisStage struct {
+	// The class name of the char filter, tokenizer or token filter.
+	Name string
+
+	// The text produced by a char filter.
+	Text string
+
+	// The tokens produced by a tokenizer or token filter.
+	Tokens []AnalysisToken
+}
+
+type AnalysisToken struct {
+	Text            string `json:"text"`
+	RawBytes        string `json:"raw_bytes,omitempty"`
+	Start           int    `json:"start"`
+	End             int    `json:"end"`
+	Position        int    `json:"position"`
+	PositionHistory []int  `json:"positionHistory,omitempty"`
+	Type            string `json:"type,omitempty"`
+	Match           bool   `json:"match,omitempty"`
+}
+
+func (a *Analysis) UnmarshalJSON(b []byte) error {
+	var sections map[string]json.RawMessage
+	if err := json.Unmarshal(b, &sections); err != nil {
+		return err
+	}
+
+	_, hasTypes := sections["field_types"]
+	_, hasNames := sections["field_names"]
+	if hasTypes || hasNames {
+		var fields struct {
+			FieldTypes map[string]FieldAnalysis `json:"field_types"`
+			FieldNames map[string]FieldAnalysis `json:"field_names"`
+		}
+		if err := json.Unmarshal(b, &fields); err != nil {
+			return err
+		}
+		a.FieldTypes = fields.FieldTypes
+		a.FieldNames = fields.FieldNames
+		return nil
+	}
+
+	a.Documents = make(map[string]map[string]DocumentFieldAnalysis, len(sections))
+	for id, raw := range sections {
+		var fields map[string]DocumentFieldAnalysis
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return err
+		}
+		a.Documents[id] = fields
+	}
+
+	return nil
+}
+
+func (s *AnalysisStages) UnmarshalJSON(b []byte) error {
+	var pairs []json.RawMessage
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")) {
+		pairs, err = orderedPairs(b)
+	} else {
+		err = json.Unmarshal(b, &pairs)
+	}
+	if err != nil {
+		return err
+	}
+
+	*s = make(AnalysisStages, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		stage := AnalysisStage{}
+		if err := json.Unmarshal(pairs[i], &stage.Name); err != nil {
+			return err
+		}
+
+		if bytes.HasPrefix(bytes.TrimSpace(pairs[i+1]), []byte(`"`)) {
+			err = json.Unmarshal(pairs[i+1], &stage.Text)
+		} else {
+			err = json.Unmarshal(pairs[i+1], &stage.Tokens)
+		}
+		if err != nil {
+			return err
+		}
+
+		*s = append(*s, stage)
+	}
+
+	return nil
+}
+
+// FIELD: Analyze text with the analyzers of fields or field types
+// The token stream of each stage is returned in Response.Analysis.
+func (a *AnalysisAPI) Field(ctx context.Context, collection string, analysis FieldAnalysisRequest) (*Response, error) {
+	analysis.WT = JSON
+
+	path := fmt.Sprintf("/solr/%s/analysis/field", collection)
+
+	req, err := a.client.NewRequest(ctx, http.MethodGet, path, nil, analysis, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := a.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// DOCUMENT: Analyze documents with the analyzers of their fields
+// The documents are not indexed, the token stream of each field is returned in Response.Analysis.
+func (a *AnalysisAPI) Document(ctx context.Context, collection string, docs []Document, analysis DocumentAnalysisRequest) (*Response, error) {
+	analysis.WT = JSON
+
+	body, err := analysisDocs(docs)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/solr/%s/analysis/document", collection)
+
+	req, err := a.client.NewRequest(ctx, http.MethodPost, path, body, analysis, &map[string]string{
+		"Content-Type": "application/xml; charset=utf-8",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := a.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// analysisDocs encodes the documents in the XML update format, the only format accepted
+// by the document analysis handler.
+func analysisDocs(docs []Document) (*bytes.Buffer, error) {
+	buf := new(bytes.Buffer)
+	encoder := xml.NewEncoder(buf)
+
+	type field struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:",chardata"`
+	}
+
+	buf.WriteString("<docs>")
+	for _, doc := range docs {
+		names := make([]string, 0, len(doc))
+		for name := range doc {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		buf.WriteString("<doc>")
+		for _, name := range names {
+			var values []interface{}
+			switch value := doc[name].(type) {
+			case []interface{}:
+				values = value
+			case []string:
+				for _, v := range value {
+					values = append(values, v)
+				}
+			default:
+				values = []interface{}{value}
+			}
+
+			for _, value := range values {
+				if err := encoder.EncodeElement(field{Name: name, Value: fmt.Sprint(value)}, xml.StartElement{Name: xml.Name{Local: "field"}}); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if err := encoder.Flush(); err != nil {
+			return nil, err
+		}
+		buf.WriteString("</doc>")
+	}
+	buf.WriteString("</docs>")
+
+	return buf, nil
+}
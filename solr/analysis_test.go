@@ -0,0 +1,89 @@
+package solr
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFieldAnalysisResponseDecode(t *testing.T) {
+	b := []byte(`{
+		"responseHeader": {"status": 0, "QTime": 1},
+		"analysis": {
+			"field_types": {},
+			"field_names": {
+				"title": {
+					"index": [
+						"org.apache.lucene.analysis.charfilter.HTMLStripCharFilter", "Running Dogs",
+						"org.apache.lucene.analysis.standard.StandardTokenizer", [
+							{"text": "Running", "raw_bytes": "[52 75 6e 6e 69 6e 67]", "start": 0, "end": 7, "position": 1, "positionHistory": [1], "type": "<ALPHANUM>"},
+							{"text": "Dogs", "raw_bytes": "[44 6f 67 73]", "start": 8, "end": 12, "position": 2, "positionHistory": [2], "type": "<ALPHANUM>"}
+						],
+						"org.apache.lucene.analysis.en.PorterStemFilter", [
+							{"text": "run", "start": 0, "end": 7, "position": 1, "positionHistory": [1, 1], "type": "<ALPHANUM>", "match": true},
+							{"text": "dog", "start": 8, "end": 12, "position": 2, "positionHistory": [2, 2], "type": "<ALPHANUM>"}
+						]
+					],
+					"query": [
+						"org.apache.lucene.analysis.standard.StandardTokenizer", [{"text": "runs", "start": 0, "end": 4, "position": 1}]
+					]
+				}
+			}
+		}
+	}`)
+
+	var response Response
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatalf("failed to decode field analysis response %v", err)
+	}
+
+	title := response.Analysis.FieldNames["title"]
+	if len(title.Index) != 3 || len(title.Query) != 1 {
+		t.Fatalf("failed to decode field analysis %v", title)
+	}
+
+	if title.Index[0].Text != "Running Dogs" || len(title.Index[0].Tokens) != 0 {
+		t.Errorf("failed to decode char filter stage %v", title.Index[0])
+	}
+
+	stem := title.Index[2]
+	if stem.Name != "org.apache.lucene.analysis.en.PorterStemFilter" || stem.Tokens[0].Text != "run" || !stem.Tokens[0].Match || stem.Tokens[1].End != 12 {
+		t.Errorf("failed to decode stemmer stage %v", stem)
+	}
+}
+
+func TestDocumentAnalysisResponseDecode(t *testing.T) {
+	b := []byte(`{
+		"responseHeader": {"status": 0, "QTime": 1},
+		"analysis": {
+			"1": {
+				"id": {"index": {"1": ["org.apache.lucene.analysis.core.KeywordTokenizer", [{"text": "1", "start": 0, "end": 1, "position": 1}]]}},
+				"title": {
+					"query": ["org.apache.lucene.analysis.standard.StandardTokenizer", [{"text": "dogs", "start": 0, "end": 4, "position": 1}]],
+					"index": {"Running Dogs": ["org.apache.lucene.analysis.standard.StandardTokenizer", [{"text": "Running", "start": 0, "end": 7, "position": 1}]]}
+				}
+			}
+		}
+	}`)
+
+	var response Response
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatalf("failed to decode document analysis response %v", err)
+	}
+
+	title := response.Analysis.Documents["1"]["title"]
+	if len(title.Query) != 1 || title.Index["Running Dogs"][0].Tokens[0].Text != "Running" {
+		t.Errorf("failed to decode document analysis %v", title)
+	}
+}
+
+func TestAnalysisDocs(t *testing.T) {
+	body, err := analysisDocs([]Document{{"id": "1", "title": "Tom & Jerry", "cat": []string{"a", "b"}}})
+	if err != nil {
+		t.Fatalf("failed to encode documents %v", err)
+	}
+
+	expected := `<docs><doc><field name="cat">a</field><field name="cat">b</field><field name="id">1</field><field name="title">Tom &amp; Jerry</field></doc></docs>`
+	if body.String() != expected {
+		t.Errorf("failed to encode documents %v", body.String())
+	}
+}
@@ -0,0 +1,87 @@
+// https://lucene.apache.org/solr/guide/8_5/collections-api.html#asynchronous-calls
+package solr
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+type AsyncState string
+
+const (
+	AsyncSubmitted AsyncState = "submitted"
+	AsyncRunning   AsyncState = "running"
+	AsyncCompleted AsyncState = "completed"
+	AsyncFailed    AsyncState = "failed"
+	AsyncNotFound  AsyncState = "notfound"
+)
+
+// The status of an asynchronous Collection API call, returned by REQUESTSTATUS. The message
+// of DELETESTATUS is returned in Msg.
+type AsyncStatus struct {
+	State AsyncState `json:"state,omitempty"`
+	Msg   string     `json:"msg,omitempty"`
+}
+
+// NewAsyncID returns a random request ID, to be used as the Async parameter of a Collection
+// API call.
+func NewAsyncID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return time.Now().Format("20060102150405.000000000")
+	}
+
+	return hex.EncodeToString(b)
+}
+
+func (s *AsyncStatus) UnmarshalJSON(b []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte(`"`)) {
+		return json.Unmarshal(b, &s.Msg)
+	}
+
+	type asyncStatus AsyncStatus
+
+	return json.Unmarshal(b, (*asyncStatus)(s))
+}
+
+// Done reports whether the call is over, either completed, failed or unknown to Solr.
+func (s AsyncStatus) Done() bool {
+	return s.State == AsyncCompleted || s.State == AsyncFailed || s.State == AsyncNotFound
+}
+
+// WaitForAsync: Wait for an Async Command
+// The status of the call is requested every pollInterval until it is completed, failed or not
+// found, the final state is returned in Response.Status. The last REQUESTSTATUS response is
+// returned along with the context error when the context is done first. A pollInterval of zero
+// or less polls every second.
+func (c *CollectionAPI) WaitForAsync(ctx context.Context, id string, pollInterval time.Duration) (*Response, error) {
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		response, err := c.RequestStatus(ctx, CollectionRequestStatus{RequestID: id})
+		if err != nil {
+			return response, err
+		}
+		if response.Status.Done() {
+			return response, nil
+		}
+		if err := response.Err(); err != nil {
+			return response, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return response, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package solr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestAsyncParameterEncode(t *testing.T) {
+	id := NewAsyncID()
+	if len(id) != 32 || id == NewAsyncID() {
+		t.Errorf("failed to generate async id %v", id)
+	}
+
+	values, err := query.Values(CollectionBackup{Collection: "tests", Name: "backup", Async: id})
+	if err != nil {
+		t.Errorf("failed to encode async parameter %v", err)
+	}
+	if values.Get("async") != id {
+		t.Errorf("failed to encode async parameter %v", values.Get("async"))
+	}
+}
+
+func TestAsyncStatusDecode(t *testing.T) {
+	var response Response
+	err := json.Unmarshal([]byte(`{"responseHeader": {"status": 0, "QTime": 1}, "status": {"state": "completed", "msg": "found [1000] in completed tasks"}}`), &response)
+	if err != nil {
+		t.Errorf("failed to decode async status %v", err)
+	}
+	if response.Status.State != AsyncCompleted || !response.Status.Done() {
+		t.Errorf("failed to decode async status %+v", response.Status)
+	}
+
+	response = Response{}
+	err = json.Unmarshal([]byte(`{"responseHeader": {"status": 0, "QTime": 1}, "status": "successfully removed stored response for [1000]"}`), &response)
+	if err != nil {
+		t.Errorf("failed to decode delete status message %v", err)
+	}
+	if response.Status.Msg != "successfully removed stored response for [1000]" || response.Status.Done() {
+		t.Errorf("failed to decode delete status message %+v", response.Status)
+	}
+}
+
+func TestWaitForAsync(t *testing.T) {
+	states := []AsyncState{AsyncSubmitted, AsyncRunning, AsyncFailed}
+	var requests int
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("action") != "REQUESTSTATUS" || r.URL.Query().Get("requestid") != "1000" {
+			t.Errorf("failed to request status %v", r.URL)
+		}
+		state := states[len(states)-1]
+		if requests < len(states) {
+			state = states[requests]
+		}
+		requests++
+
+		if state == AsyncFailed {
+			_, _ = w.Write([]byte(`{"responseHeader": {"status": 0}, "exception": {"msg": "backup failed", "rspCode": 500}, "status": {"state": "failed"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"responseHeader": {"status": 0}, "status": {"state": "` + string(state) + `"}}`))
+	})
+	defer server.Close()
+
+	response, err := client.Collection.WaitForAsync(context.Background(), "1000", time.Millisecond)
+	if err != nil {
+		t.Fatalf("failed to wait for async %v", err)
+	}
+	if response.Status.State != AsyncFailed || requests != 3 {
+		t.Errorf("failed to wait for async %+v after %d requests", response.Status, requests)
+	}
+
+	states = []AsyncState{AsyncRunning}
+	requests = 0
+	ctx, cancel := context.WithTimeout(context.Background(), 25*time.Millisecond)
+	defer cancel()
+
+	response, err = client.Collection.WaitForAsync(ctx, "1000", 10*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("failed to stop waiting at the context deadline %v", err)
+	}
+	if response != nil && response.Status.State != AsyncRunning {
+		t.Errorf("failed to return the last status %+v", response)
+	}
+	states = []AsyncState{AsyncCompleted}
+	requests = 0
+
+	response, err = client.Collection.WaitForAsync(context.Background(), "1000", 0)
+	if err != nil {
+		t.Fatalf("failed to wait for async with the default poll interval %v", err)
+	}
+	if response.Status.State != AsyncCompleted || requests != 1 {
+		t.Errorf("failed to wait for async with the default poll interval %+v", response.Status)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
@@ -14,8 +15,9 @@ import (
 )
 
 const (
-	DefaultHost        = "http://127.0.0.1:8983"
-	DefaultContentType = "application/json"
+	DefaultHost         = "http://127.0.0.1:8983"
+	DefaultContentType  = "application/json"
+	DefaultMaxURLLength = 4096
 )
 
 type Client struct {
@@ -24,9 +26,14 @@ type Client struct {
 	Document           DocumentAPI
 	Collection         CollectionAPI
 	Config             ConfigAPI
+	Analysis           AnalysisAPI
+	LTR                LTRAPI
+	Schema             SchemaAPI
+	CollectionConfig   CollectionConfigAPI
 	onRequestCompleted RequestCompletionCallback
 	username           string
 	password           string
+	maxURLLength       int
 }
 
 type RequestCompletionCallback func(*http.Request, *http.Response)
@@ -37,8 +44,9 @@ func NewClient() Client {
 	baseURL, _ := url.Parse(DefaultHost)
 
 	client := Client{
-		client:  httpClient,
-		baseURL: baseURL,
+		client:       httpClient,
+		baseURL:      baseURL,
+		maxURLLength: DefaultMaxURLLength,
 	}
 
 	client.Initialize()
@@ -54,6 +62,14 @@ func (c *Client) Initialize() {
 	c.Collection = collection
 	config := ConfigAPI{client: c}
 	c.Config = config
+	analysis := AnalysisAPI{client: c}
+	c.Analysis = analysis
+	ltr := LTRAPI{client: c}
+	c.LTR = ltr
+	schema := SchemaAPI{client: c}
+	c.Schema = schema
+	collectionConfig := CollectionConfigAPI{client: c}
+	c.CollectionConfig = collectionConfig
 }
 
 // SET HTTP CLIENT: Set HTTP Client Instance
@@ -78,6 +94,13 @@ func (c *Client) SetBaseURL(baseURL string) *Client {
 	return c
 }
 
+// SET MAX URL LENGTH: Set the URL length above which searches are sent using POST
+func (c *Client) SetMaxURLLength(maxURLLength int) *Client {
+	c.maxURLLength = maxURLLength
+	c.Initialize()
+	return c
+}
+
 // NEW UPLOAD: New Request Upload
 func (c *Client) NewUpload(ctx context.Context, urlStr string, filepath string, queryStrings interface{}) (*Response, error) {
 	u, err := c.baseURL.Parse(urlStr)
@@ -116,6 +139,7 @@ func (c *Client) NewUpload(ctx context.Context, urlStr string, filepath string,
 }
 
 // NEW REQUEST: New Request
+// Bodies implementing io.Reader are sent as they are, any other body is encoded as JSON.
 func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}, queryStrings interface{}, headers *map[string]string) (*http.Request, error) {
 	u, err := c.baseURL.Parse(urlStr)
 	if err != nil {
@@ -123,7 +147,12 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 	}
 
 	buf := new(bytes.Buffer)
-	if body != nil {
+	if reader, ok := body.(io.Reader); ok {
+		_, err = buf.ReadFrom(reader)
+		if err != nil {
+			return nil, err
+		}
+	} else if body != nil {
 		err = json.NewEncoder(buf).Encode(body)
 		if err != nil {
 			return nil, err
@@ -187,6 +216,20 @@ func (c *Client) NewRequestUpload(ctx context.Context, method, urlStr string, bo
 
 // DO: Response Handle
 func (c *Client) Do(ctx context.Context, req *http.Request) (*Response, error) {
+	response := Response{}
+
+	resp, err := c.do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+	response.HttpResponse = resp
+
+	return &response, nil
+}
+
+// do sends the request and decodes the JSON response into v, for the APIs whose response
+// doesn't fit in Response.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
 	req = req.WithContext(ctx)
 	resp, err := c.client.Do(req)
 	if err != nil {
@@ -208,14 +251,12 @@ func (c *Client) Do(ctx context.Context, req *http.Request) (*Response, error) {
 		return nil, err
 	}
 
-	response := Response{HttpResponse: resp}
-
-	err = json.Unmarshal(b, &response)
+	err = json.Unmarshal(b, v)
 	if err != nil {
 		return nil, err
 	}
 
-	return &response, nil
+	return resp, nil
 }
 
 // ON REQUEST COMPLETED: On Request Completed Handle
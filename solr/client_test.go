@@ -0,0 +1,17 @@
+package solr
+
+import (
+	"net/http"
+	"net/http/httptest"
+)
+
+// newTestClient starts a server answering with handler and returns a client sending its
+// requests to it. The server has to be closed by the caller.
+func newTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	client := NewClient()
+	client.SetBaseURL(server.URL)
+
+	return &client, server
+}
@@ -0,0 +1,332 @@
+// https://lucene.apache.org/solr/guide/8_5/cluster-node-management.html#clusterstatus
+package solr
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type ReplicaType string
+
+const (
+	NRTReplica  ReplicaType = "NRT"
+	TLOGReplica ReplicaType = "TLOG"
+	PULLReplica ReplicaType = "PULL"
+)
+
+type ReplicaState string
+
+const (
+	ReplicaActive         ReplicaState = "active"
+	ReplicaDown           ReplicaState = "down"
+	ReplicaRecovering     ReplicaState = "recovering"
+	ReplicaRecoveryFailed ReplicaState = "recovery_failed"
+)
+
+type ShardState string
+
+const (
+	ShardActive       ShardState = "active"
+	ShardInactive     ShardState = "inactive"
+	ShardConstruction ShardState = "construction"
+	ShardRecovery     ShardState = "recovery"
+)
+
+// The state of a SolrCloud cluster, as returned by CLUSTERSTATUS.
+type ClusterState struct {
+	Collections map[string]CollectionState `json:"collections,omitempty"`
+
+	// The collections of each alias, as a comma-separated list.
+	Aliases map[string]string `json:"aliases,omitempty"`
+
+	// The nodes of each role, e.g. overseer.
+	Roles      map[string][]string    `json:"roles,omitempty"`
+	LiveNodes  []string               `json:"live_nodes,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+type CollectionState struct {
+	// The configset used by the collection.
+	ConfigName        string           `json:"configName,omitempty"`
+	Shards            map[string]Shard `json:"shards,omitempty"`
+	Router            Router           `json:"router,omitempty"`
+	ReplicationFactor FlexInt          `json:"replicationFactor,omitempty"`
+	NRTReplicas       FlexInt          `json:"nrtReplicas,omitempty"`
+	TLOGReplicas      FlexInt          `json:"tlogReplicas,omitempty"`
+	PullReplicas      FlexInt          `json:"pullReplicas,omitempty"`
+	MaxShardsPerNode  FlexInt          `json:"maxShardsPerNode,omitempty"`
+	AutoAddReplicas   FlexBool         `json:"autoAddReplicas,omitempty"`
+	ZNodeVersion      int              `json:"znodeVersion,omitempty"`
+
+	// The aliases referring to the collection.
+	Aliases []string `json:"aliases,omitempty"`
+
+	// The health of the collection, GREEN, YELLOW, ORANGE or RED.
+	Health string `json:"health,omitempty"`
+}
+
+type Router struct {
+	// The router name, compositeId or implicit.
+	Name string `json:"name,omitempty"`
+
+	// The field used to route documents, if any.
+	Field string `json:"field,omitempty"`
+}
+
+type Shard struct {
+	// The hash range of the shard, empty with the implicit router.
+	Range    HashRange          `json:"range,omitempty"`
+	State    ShardState         `json:"state,omitempty"`
+	Replicas map[string]Replica `json:"replicas,omitempty"`
+	Parent   string             `json:"parent,omitempty"`
+}
+
+type Replica struct {
+	Core          string       `json:"core,omitempty"`
+	NodeName      string       `json:"node_name,omitempty"`
+	BaseURL       string       `json:"base_url,omitempty"`
+	State         ReplicaState `json:"state,omitempty"`
+	Type          ReplicaType  `json:"type,omitempty"`
+	Leader        FlexBool     `json:"leader,omitempty"`
+	ForceSetState FlexBool     `json:"force_set_state,omitempty"`
+
+	// Any property set with ADDREPLICAPROP, e.g. property.preferredleader.
+	Properties map[string]interface{} `json:"-"`
+}
+
+// A range of the 32-bit hash space of the compositeId router, written by Solr as
+// hexadecimal bounds, e.g. 80000000-ffffffff.
+type HashRange struct {
+	Min int32
+	Max int32
+
+	// False when the shard has no range, e.g. with the implicit router.
+	Valid bool
+}
+
+// Returned when a collection can't serve all of its documents from active replicas.
+type UnhealthyCollectionError struct {
+	Collection string
+	Problems   []string
+}
+
+// A boolean Solr writes either as a JSON boolean or as a string.
+type FlexBool bool
+
+// An integer Solr writes either as a JSON number or as a string.
+type FlexInt int
+
+func (e *UnhealthyCollectionError) Error() string {
+	return fmt.Sprintf("solr: collection %s is not healthy: %s", e.Collection, strings.Join(e.Problems, "; "))
+}
+
+func (r Replica) MarshalJSON() ([]byte, error) {
+	type replica Replica
+
+	return marshalWithAttributes(replica(r), r.Properties)
+}
+
+func (r *Replica) UnmarshalJSON(b []byte) error {
+	type replica Replica
+
+	return unmarshalWithAttributes(b, (*replica)(r), &r.Properties)
+}
+
+// ParseHashRange parses a hash range written as two hexadecimal bounds, e.g. 80000000-ffffffff.
+func ParseHashRange(s string) (HashRange, error) {
+	parts := strings.SplitN(s, "-", 2)
+	if len(parts) != 2 {
+		return HashRange{}, fmt.Errorf("solr: invalid hash range %q", s)
+	}
+
+	min, err := strconv.ParseUint(parts[0], 16, 32)
+	if err != nil {
+		return HashRange{}, fmt.Errorf("solr: invalid hash range %q: %v", s, err)
+	}
+	max, err := strconv.ParseUint(parts[1], 16, 32)
+	if err != nil {
+		return HashRange{}, fmt.Errorf("solr: invalid hash range %q: %v", s, err)
+	}
+
+	return HashRange{Min: int32(uint32(min)), Max: int32(uint32(max)), Valid: true}, nil
+}
+
+func (h HashRange) String() string {
+	if !h.Valid {
+		return ""
+	}
+
+	return fmt.Sprintf("%08x-%08x", uint32(h.Min), uint32(h.Max))
+}
+
+// Includes reports whether the hash falls into the range.
+func (h HashRange) Includes(hash int32) bool {
+	return h.Valid && h.Min <= hash && hash <= h.Max
+}
+
+func (h HashRange) MarshalJSON() ([]byte, error) {
+	if !h.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(h.String())
+}
+
+func (h *HashRange) UnmarshalJSON(b []byte) error {
+	var s *string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if s == nil || *s == "" {
+		*h = HashRange{}
+		return nil
+	}
+
+	r, err := ParseHashRange(*s)
+	if err != nil {
+		return err
+	}
+	*h = r
+
+	return nil
+}
+
+func (f *FlexBool) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	switch value := v.(type) {
+	case bool:
+		*f = FlexBool(value)
+	case string:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		*f = FlexBool(parsed)
+	case nil:
+		*f = false
+	default:
+		return fmt.Errorf("solr: invalid boolean %s", b)
+	}
+
+	return nil
+}
+
+func (f *FlexInt) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	switch value := v.(type) {
+	case float64:
+		*f = FlexInt(value)
+	case string:
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		*f = FlexInt(parsed)
+	case nil:
+		*f = 0
+	default:
+		return fmt.Errorf("solr: invalid integer %s", b)
+	}
+
+	return nil
+}
+
+// AliasCollections returns the collections an alias refers to.
+func (c ClusterState) AliasCollections(alias string) []string {
+	collections, ok := c.Aliases[alias]
+	if !ok || collections == "" {
+		return nil
+	}
+
+	return strings.Split(collections, ",")
+}
+
+// IsLive reports whether a node, e.g. 127.0.0.1:8983_solr, is live.
+func (c ClusterState) IsLive(node string) bool {
+	for _, live := range c.LiveNodes {
+		if live == node {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ShardNames returns the names of the shards of the collection, sorted.
+func (c CollectionState) ShardNames() []string {
+	names := make([]string, 0, len(c.Shards))
+	for name := range c.Shards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Leader returns the name and the state of the leader replica of the shard, ok is false when
+// the shard has no leader.
+func (s Shard) Leader() (name string, replica Replica, ok bool) {
+	for name, replica := range s.Replicas {
+		if replica.Leader {
+			return name, replica, true
+		}
+	}
+
+	return "", Replica{}, false
+}
+
+// CheckCollection returns an *UnhealthyCollectionError when the collection is missing, when an
+// active shard has no active leader or when a replica of an active shard is not active on a
+// live node.
+func (c ClusterState) CheckCollection(name string) error {
+	collection, ok := c.Collections[name]
+	if !ok {
+		return &UnhealthyCollectionError{Collection: name, Problems: []string{"collection not found"}}
+	}
+
+	var problems []string
+	for _, shardName := range collection.ShardNames() {
+		shard := collection.Shards[shardName]
+		if shard.State != ShardActive {
+			continue
+		}
+
+		if _, leader, ok := shard.Leader(); !ok || leader.State != ReplicaActive {
+			problems = append(problems, fmt.Sprintf("%s: no active leader", shardName))
+		}
+
+		replicaNames := make([]string, 0, len(shard.Replicas))
+		for replicaName := range shard.Replicas {
+			replicaNames = append(replicaNames, replicaName)
+		}
+		sort.Strings(replicaNames)
+
+		for _, replicaName := range replicaNames {
+			replica := shard.Replicas[replicaName]
+			switch {
+			case replica.State != ReplicaActive:
+				problems = append(problems, fmt.Sprintf("%s/%s: replica is %s", shardName, replicaName, replica.State))
+			case !c.IsLive(replica.NodeName):
+				problems = append(problems, fmt.Sprintf("%s/%s: node %s is not live", shardName, replicaName, replica.NodeName))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return &UnhealthyCollectionError{Collection: name, Problems: problems}
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package solr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+// testClusterState returns a cluster of three live nodes holding the events collection, led on
+// a:8983_solr with a replica on b:8983_solr, and the logs collection, with a replica on
+// a:8983_solr and led on c:8983_solr.
+func testClusterState() ClusterState {
+	return ClusterState{
+		LiveNodes: []string{"a:8983_solr", "b:8983_solr", "c:8983_solr"},
+		Collections: map[string]CollectionState{
+			"events": {Shards: map[string]Shard{
+				"shard1": {State: ShardActive, Replicas: map[string]Replica{
+					"core_node1": {NodeName: "a:8983_solr", State: ReplicaActive, Leader: true},
+					"core_node2": {NodeName: "b:8983_solr", State: ReplicaActive},
+				}},
+			}},
+			"logs": {Shards: map[string]Shard{
+				"shard1": {State: ShardActive, Replicas: map[string]Replica{
+					"core_node3": {NodeName: "a:8983_solr", State: ReplicaActive},
+					"core_node4": {NodeName: "c:8983_solr", State: ReplicaActive, Leader: true},
+				}},
+			}},
+		},
+	}
+}
+
+// writeClusterStatus writes a CLUSTERSTATUS response holding the cluster state.
+func writeClusterStatus(w http.ResponseWriter, state ClusterState) {
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"cluster": state})
+}
+
+func TestClusterStatusParameters(t *testing.T) {
+	values, err := query.Values(CollectionClusterStatus{
+		collectionBase: collectionBase{Action: ClusterStatusAction, WT: JSON},
+		Collection:     "events",
+		Shard:          []string{"shard1", "shard2"},
+		Route:          "a!",
+	})
+	if err != nil {
+		t.Errorf("failed to encode cluster status parameters %v", err)
+	}
+
+	if values.Encode() != "_route_=a%21&action=CLUSTERSTATUS&collection=events&shard=shard1%2Cshard2&wt=json" {
+		t.Errorf("failed to encode cluster status parameters %v", values.Encode())
+	}
+}
+
+func TestClusterStatusDecode(t *testing.T) {
+	b := []byte(`{
+		"responseHeader": {"status": 0, "QTime": 3},
+		"cluster": {
+			"collections": {
+				"events": {
+					"pullReplicas": "0",
+					"replicationFactor": 2,
+					"shards": {
+						"shard1": {
+							"range": "80000000-ffffffff",
+							"state": "active",
+							"replicas": {
+								"core_node3": {
+									"core": "events_shard1_replica_n1",
+									"node_name": "127.0.0.1:8983_solr",
+									"base_url": "http://127.0.0.1:8983/solr",
+									"state": "active",
+									"type": "NRT",
+									"force_set_state": "false",
+									"leader": "true",
+									"property.preferredleader": "true"
+								},
+								"core_node5": {
+									"core": "events_shard1_replica_p2",
+									"node_name": "127.0.0.1:7574_solr",
+									"base_url": "http://127.0.0.1:7574/solr",
+									"state": "down",
+									"type": "PULL"
+								}
+							}
+						},
+						"shard2": {"range": "0-7fffffff", "state": "inactive", "replicas": {}}
+					},
+					"router": {"name": "compositeId"},
+					"maxShardsPerNode": "-1",
+					"autoAddReplicas": "false",
+					"nrtReplicas": 2,
+					"tlogReplicas": "0",
+					"health": "YELLOW",
+					"znodeVersion": 11,
+					"configName": "events",
+					"aliases": ["logs"]
+				}
+			},
+			"aliases": {"logs": "events,events_old"},
+			"roles": {"overseer": ["127.0.0.1:8983_solr"]},
+			"live_nodes": ["127.0.0.1:8983_solr", "127.0.0.1:7574_solr"]
+		}
+	}`)
+
+	var response Response
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatalf("failed to decode cluster status %v", err)
+	}
+
+	cluster := response.Cluster
+	collection := cluster.Collections["events"]
+	if collection.ConfigName != "events" || collection.ReplicationFactor != 2 || collection.MaxShardsPerNode != -1 ||
+		collection.AutoAddReplicas || collection.Router.Name != "compositeId" || collection.Health != "YELLOW" {
+		t.Errorf("failed to decode collection %+v", collection)
+	}
+	if !reflect.DeepEqual(collection.ShardNames(), []string{"shard1", "shard2"}) {
+		t.Errorf("failed to decode shards %v", collection.ShardNames())
+	}
+
+	shard := collection.Shards["shard1"]
+	if shard.Range.String() != "80000000-ffffffff" || !shard.Range.Includes(-1) || shard.Range.Includes(0) {
+		t.Errorf("failed to decode range %+v", shard.Range)
+	}
+	if collection.Shards["shard2"].Range.Min != 0 || collection.Shards["shard2"].Range.Max != 0x7fffffff {
+		t.Errorf("failed to decode range %+v", collection.Shards["shard2"].Range)
+	}
+
+	name, leader, ok := shard.Leader()
+	if !ok || name != "core_node3" || leader.Type != NRTReplica || leader.State != ReplicaActive {
+		t.Errorf("failed to decode leader %v %+v", name, leader)
+	}
+	if leader.Properties["property.preferredleader"] != "true" {
+		t.Errorf("failed to decode properties %v", leader.Properties)
+	}
+	if replica := shard.Replicas["core_node5"]; replica.Type != PULLReplica || replica.State != ReplicaDown || replica.Leader {
+		t.Errorf("failed to decode replica %+v", replica)
+	}
+
+	if !reflect.DeepEqual(cluster.AliasCollections("logs"), []string{"events", "events_old"}) {
+		t.Errorf("failed to decode alias collections %v", cluster.AliasCollections("logs"))
+	}
+	if !cluster.IsLive("127.0.0.1:7574_solr") || cluster.IsLive("127.0.0.1:8984_solr") {
+		t.Errorf("failed to decode live nodes %v", cluster.LiveNodes)
+	}
+	if !reflect.DeepEqual(cluster.Roles["overseer"], []string{"127.0.0.1:8983_solr"}) {
+		t.Errorf("failed to decode roles %v", cluster.Roles)
+	}
+}
+
+func TestClusterStatus(t *testing.T) {
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("action") != "CLUSTERSTATUS" {
+			t.Errorf("failed to request cluster status %v", r.URL)
+		}
+		writeClusterStatus(w, testClusterState())
+	})
+	defer server.Close()
+
+	response, err := client.Collection.ClusterStatus(context.Background(), CollectionClusterStatus{})
+	if err != nil {
+		t.Fatalf("failed to request cluster status %v", err)
+	}
+
+	if !reflect.DeepEqual(response.Cluster, testClusterState()) {
+		t.Errorf("failed to decode cluster status %+v", response.Cluster)
+	}
+}
@@ -30,6 +30,29 @@ const (
 	BackupAction            CollectionAction = "BACKUP"
 	RestoreAction           CollectionAction = "RESTORE"
 	RebalanceLeadersAction  CollectionAction = "REBALANCELEADERS"
+	ClusterStatusAction     CollectionAction = "CLUSTERSTATUS"
+	RequestStatusAction     CollectionAction = "REQUESTSTATUS"
+	DeleteStatusAction      CollectionAction = "DELETESTATUS"
+	CreateAliasAction       CollectionAction = "CREATEALIAS"
+	ListAliasesAction       CollectionAction = "LISTALIASES"
+	DeleteAliasAction       CollectionAction = "DELETEALIAS"
+	AliasPropAction         CollectionAction = "ALIASPROP"
+	SplitShardAction        CollectionAction = "SPLITSHARD"
+	CreateShardAction       CollectionAction = "CREATESHARD"
+	DeleteShardAction       CollectionAction = "DELETESHARD"
+	AddReplicaAction        CollectionAction = "ADDREPLICA"
+	DeleteReplicaAction     CollectionAction = "DELETEREPLICA"
+	MoveReplicaAction       CollectionAction = "MOVEREPLICA"
+	AddReplicaPropAction    CollectionAction = "ADDREPLICAPROP"
+	DeleteReplicaPropAction CollectionAction = "DELETEREPLICAPROP"
+	DeleteNodeAction        CollectionAction = "DELETENODE"
+	ReplaceNodeAction       CollectionAction = "REPLACENODE"
+
+	// Creates the collection of a routed alias a route key falls into.
+	MaintainRoutedAliasAction CollectionAction = "MAINTAINROUTEDALIAS"
+
+	// Distributes a property such as preferredLeader evenly across the shards of a collection.
+	BalanceShardUniqueAction CollectionAction = "BALANCESHARDUNIQUE"
 )
 
 type ReindexCollectionCmd string
@@ -197,7 +220,7 @@ type CollectionCreate struct {
 	// If true, the request will complete only when all affected replicas become active. The
 	// default is false, which means that the API will return the status of the single action,
 	// which may be before the new replica is online and active.
-	WaitForFinalState string `url:"waitForFinalState,omitempty"`
+	WaitForFinalState bool `url:"waitForFinalState,omitempty"`
 
 	// The name of the collection with which all replicas of this collection must be co-located.
 	// The collection must already exist and must have a single shard named shard1. See Colocating
@@ -210,7 +233,7 @@ type CollectionCreate struct {
 	Alias string `url:"alias,omitempty"`
 
 	// Request ID to track this action which will be processed asynchronously.
-	Async bool `url:"async,omitempty"`
+	Async string `url:"async,omitempty"`
 }
 
 type CollectionReload struct {
@@ -220,7 +243,7 @@ type CollectionReload struct {
 	Name string `url:"name,omitempty"`
 
 	// Request ID to track this action which will be processed asynchronously.
-	Async bool `url:"async,omitempty"`
+	Async string `url:"async,omitempty"`
 }
 
 type CollectionModifyCollection struct {
@@ -280,7 +303,7 @@ type CollectionDelete struct {
 	Name string `url:"name,omitempty"`
 
 	// Request ID to track this action which will be processed asynchronously.
-	Async bool `url:"async,omitempty"`
+	Async string `url:"async,omitempty"`
 }
 
 type CollectionProp struct {
@@ -318,7 +341,7 @@ type CollectionMigrate struct {
 	PropertyName string `url:"property.name,omitempty"`
 
 	// Request ID to track this action which will be processed asynchronously.
-	Async bool `url:"async,omitempty"`
+	Async string `url:"async,omitempty"`
 }
 
 type CollectionReindex struct {
@@ -363,7 +386,7 @@ type CollectionReindex struct {
 	RemoveSource string `url:"removeSource,omitempty"`
 
 	// Request ID to track this action which will be processed asynchronously.
-	Async bool `url:"async,omitempty"`
+	Async string `url:"async,omitempty"`
 }
 
 type CollectionColStatus struct {
@@ -455,7 +478,7 @@ type CollectionBackup struct {
 	Location string `url:"location,omitempty"`
 
 	// Request ID to track this action which will be processed asynchronously.
-	Async bool `url:"async,omitempty"`
+	Async string `url:"async,omitempty"`
 }
 
 type CollectionRestore struct {
@@ -472,7 +495,7 @@ type CollectionRestore struct {
 	Location string `url:"location,omitempty"`
 
 	// Request ID to track this action which will be processed asynchronously.
-	Async bool `url:"async,omitempty"`
+	Async string `url:"async,omitempty"`
 
 	// The name of a repository to be used for the backup. If no repository is specified then the
 	// local filesystem repository will be used automatically.
@@ -540,6 +563,39 @@ type CollectionRebalanceLeaders struct {
 	MaxWaitSeconds string `url:"maxWaitSeconds,omitempty"`
 }
 
+type CollectionClusterStatus struct {
+	collectionBase
+
+	// The collection or alias name for which information is requested. If omitted, information
+	// on all collections in the cluster will be returned.
+	Collection string `url:"collection,omitempty"`
+
+	// The shard(s) for which information is requested. Multiple shard names can be specified
+	// as a comma-separated list.
+	Shard []string `url:"shard,comma,omitempty"`
+
+	// This can be used if you need the details of the shard where a particular document belongs
+	// to and you don’t know which shard it falls under.
+	Route string `url:"_route_,omitempty"`
+}
+
+type CollectionRequestStatus struct {
+	collectionBase
+
+	// The user-defined request ID for the request. This parameter is required.
+	RequestID string `url:"requestid,omitempty"`
+}
+
+type CollectionDeleteStatus struct {
+	collectionBase
+
+	// The request ID of the asynchronous call whose stored response should be cleared.
+	RequestID string `url:"requestid,omitempty"`
+
+	// Set to true to clear all stored completed and failed async request responses.
+	Flush bool `url:"flush,omitempty"`
+}
+
 type CollectionAPI struct {
 	client *Client
 }
@@ -783,3 +839,62 @@ func (c *CollectionAPI) RebalanceLeaders(ctx context.Context, collection Collect
 
 	return response, err
 }
+
+// ClusterStatus: Cluster Status
+// Fetch the cluster status including collections, shards, replicas, configuration name as well as
+// collection aliases and cluster properties. The status is returned in Response.Cluster.
+func (c *CollectionAPI) ClusterStatus(ctx context.Context, collection CollectionClusterStatus) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = ClusterStatusAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// RequestStatus: Request Status for Async Commands
+// Request the status and response of an already submitted asynchronous Collection API call. The
+// status is returned in Response.Status.
+func (c *CollectionAPI) RequestStatus(ctx context.Context, collection CollectionRequestStatus) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = RequestStatusAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// DeleteStatus: Delete Status
+// Deletes the stored response of an already failed or completed asynchronous Collection API call.
+func (c *CollectionAPI) DeleteStatus(ctx context.Context, collection CollectionDeleteStatus) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = DeleteStatusAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
@@ -0,0 +1,430 @@
+// https://lucene.apache.org/solr/guide/8_5/config-api.html
+// https://lucene.apache.org/solr/guide/8_5/request-parameters-api.html
+package solr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// The sections of solrconfig.xml, read with CollectionConfigAPI.Section.
+const (
+	ConfigQuery               = "query"
+	ConfigRequestHandler      = "requestHandler"
+	ConfigSearchComponent     = "searchComponent"
+	ConfigUpdateHandler       = "updateHandler"
+	ConfigRequestDispatcher   = "requestDispatcher"
+	ConfigQueryResponseWriter = "queryResponseWriter"
+	ConfigInitParams          = "initParams"
+	ConfigListener            = "listener"
+)
+
+// The plugin types of the add-, update- and delete- commands of the Config API.
+const (
+	RequestHandlerPlugin      = "requesthandler"
+	SearchComponentPlugin     = "searchcomponent"
+	InitParamsPlugin          = "initparams"
+	QueryResponseWriterPlugin = "queryresponsewriter"
+	QueryParserPlugin         = "queryparser"
+	ValueSourceParserPlugin   = "valuesourceparser"
+	TransformerPlugin         = "transformer"
+	UpdateProcessorPlugin     = "updateprocessor"
+	QueryConverterPlugin      = "queryconverter"
+	ListenerPlugin            = "listener"
+	RuntimeLibPlugin          = "runtimelib"
+)
+
+const (
+	SetPropertyCommand       = "set-property"
+	UnsetPropertyCommand     = "unset-property"
+	SetUserPropertyCommand   = "set-user-property"
+	UnsetUserPropertyCommand = "unset-user-property"
+	SetParamsCommand         = "set"
+	UpdateParamsCommand      = "update"
+	UnsetParamsCommand       = "unset"
+)
+
+type configBase struct {
+	WT WT `url:"wt,omitempty"`
+}
+
+type ConfigSectionParameters struct {
+	configBase
+
+	// The name of a single component of the section to return, e.g. /select.
+	ComponentName string `url:"componentName,omitempty"`
+
+	// If true, the parameter sets used by the request handlers are expanded in the response.
+	ExpandParams bool `url:"expandParams,omitempty"`
+}
+
+type CollectionConfigAPI struct {
+	client *Client
+}
+
+// The effective configuration of a collection, solrconfig.xml merged with the overlay. The
+// sections without a field of their own are kept in Attributes.
+type SolrConfig struct {
+	ZNodeVersion        int                     `json:"znodeVersion,omitempty"`
+	LuceneMatchVersion  string                  `json:"luceneMatchVersion,omitempty"`
+	UpdateHandler       map[string]interface{}  `json:"updateHandler,omitempty"`
+	Query               map[string]interface{}  `json:"query,omitempty"`
+	RequestDispatcher   map[string]interface{}  `json:"requestDispatcher,omitempty"`
+	RequestHandler      map[string]PluginConfig `json:"requestHandler,omitempty"`
+	SearchComponent     map[string]PluginConfig `json:"searchComponent,omitempty"`
+	QueryResponseWriter map[string]PluginConfig `json:"queryResponseWriter,omitempty"`
+	Attributes          map[string]interface{}  `json:"-"`
+}
+
+// The changes made to solrconfig.xml through the Config API, stored in configoverlay.json.
+type ConfigOverlay struct {
+	ZNodeVersion    int                     `json:"znodeVersion,omitempty"`
+	Props           map[string]interface{}  `json:"props,omitempty"`
+	UserProps       map[string]interface{}  `json:"userProps,omitempty"`
+	RequestHandler  map[string]PluginConfig `json:"requestHandler,omitempty"`
+	SearchComponent map[string]PluginConfig `json:"searchComponent,omitempty"`
+	Attributes      map[string]interface{}  `json:"-"`
+}
+
+// A plugin of solrconfig.xml, e.g. a request handler or a search component.
+type PluginConfig struct {
+	Name            string                 `json:"name,omitempty"`
+	Class           string                 `json:"class,omitempty"`
+	StartUp         string                 `json:"startup,omitempty"`
+	UseParams       string                 `json:"useParams,omitempty"`
+	Defaults        map[string]interface{} `json:"defaults,omitempty"`
+	Appends         map[string]interface{} `json:"appends,omitempty"`
+	Invariants      map[string]interface{} `json:"invariants,omitempty"`
+	Components      []string               `json:"components,omitempty"`
+	FirstComponents []string               `json:"first-components,omitempty"`
+	LastComponents  []string               `json:"last-components,omitempty"`
+
+	// Any other attribute of the plugin, e.g. queryFieldType for a spellcheck component.
+	Attributes map[string]interface{} `json:"-"`
+}
+
+// The response of the Request Parameters API, the parameter sets are returned in
+// ParamsResponse.Response.Params.
+type ParamsResponse struct {
+	HttpResponse   *http.Response `json:"-"`
+	ResponseHeader ResponseHeader `json:"responseHeader,omitempty"`
+	Response       ParamSets      `json:"response,omitempty"`
+	Error          Error          `json:"error,omitempty"`
+}
+
+type ParamSets struct {
+	// The version of the params.json znode holding the parameter sets.
+	ZNodeVersion int                 `json:"znodeVersion,omitempty"`
+	Params       map[string]ParamSet `json:"params,omitempty"`
+}
+
+// Err returns the error reported by Solr in the response, nil when the request succeeded.
+func (r *ParamsResponse) Err() error {
+	if r.Error.Code != 0 || r.Error.Msg != "" {
+		return r.Error
+	}
+
+	return nil
+}
+
+// A parameter set of the Request Parameters API.
+type ParamSet struct {
+	Params map[string]interface{}
+
+	// The version of the parameter set, incremented on each change.
+	Version int
+}
+
+// Config API commands, sent in a single request and applied in the order they were added.
+type ConfigCommands struct {
+	commands commandList
+}
+
+// Request Parameters API commands, sent in a single request and applied in the order they were added.
+type ParamsCommands struct {
+	commands commandList
+}
+
+func (c SolrConfig) MarshalJSON() ([]byte, error) {
+	type solrConfig SolrConfig
+
+	return marshalWithAttributes(solrConfig(c), c.Attributes)
+}
+
+func (c *SolrConfig) UnmarshalJSON(b []byte) error {
+	type solrConfig SolrConfig
+
+	return unmarshalWithAttributes(b, (*solrConfig)(c), &c.Attributes)
+}
+
+func (o ConfigOverlay) MarshalJSON() ([]byte, error) {
+	type configOverlay ConfigOverlay
+
+	return marshalWithAttributes(configOverlay(o), o.Attributes)
+}
+
+func (o *ConfigOverlay) UnmarshalJSON(b []byte) error {
+	type configOverlay ConfigOverlay
+
+	return unmarshalWithAttributes(b, (*configOverlay)(o), &o.Attributes)
+}
+
+func (p PluginConfig) MarshalJSON() ([]byte, error) {
+	type pluginConfig PluginConfig
+
+	return marshalWithAttributes(pluginConfig(p), p.Attributes)
+}
+
+func (p *PluginConfig) UnmarshalJSON(b []byte) error {
+	type pluginConfig PluginConfig
+
+	return unmarshalWithAttributes(b, (*pluginConfig)(p), &p.Attributes)
+}
+
+// UnmarshalJSON decodes a parameter set, Solr stores its version in the "" parameter as {"v": <version>}.
+func (p *ParamSet) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &p.Params); err != nil {
+		return err
+	}
+
+	if meta, ok := p.Params[""].(map[string]interface{}); ok {
+		if version, ok := meta["v"].(float64); ok {
+			p.Version = int(version)
+		}
+		delete(p.Params, "")
+	}
+
+	return nil
+}
+
+func (p ParamSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Params)
+}
+
+// SetProperty sets a common property of solrconfig.xml, e.g. updateHandler.autoCommit.maxTime.
+func (c *ConfigCommands) SetProperty(name string, value interface{}) *ConfigCommands {
+	return c.add(SetPropertyCommand, map[string]interface{}{name: value})
+}
+
+// UnsetProperty removes a property set with SetProperty, restoring the value of solrconfig.xml.
+func (c *ConfigCommands) UnsetProperty(name string) *ConfigCommands {
+	return c.add(UnsetPropertyCommand, name)
+}
+
+// SetUserProperty sets a user-defined property, substituted for ${name} in solrconfig.xml.
+func (c *ConfigCommands) SetUserProperty(name string, value interface{}) *ConfigCommands {
+	return c.add(SetUserPropertyCommand, map[string]interface{}{name: value})
+}
+
+// UnsetUserProperty removes a user-defined property.
+func (c *ConfigCommands) UnsetUserProperty(name string) *ConfigCommands {
+	return c.add(UnsetUserPropertyCommand, name)
+}
+
+// AddPlugin adds a plugin of the given type, e.g. SearchComponentPlugin.
+func (c *ConfigCommands) AddPlugin(pluginType string, plugin PluginConfig) *ConfigCommands {
+	return c.add("add-"+pluginType, plugin)
+}
+
+// UpdatePlugin replaces the definition of an existing plugin of the given type.
+func (c *ConfigCommands) UpdatePlugin(pluginType string, plugin PluginConfig) *ConfigCommands {
+	return c.add("update-"+pluginType, plugin)
+}
+
+// DeletePlugin deletes a plugin of the given type by name.
+func (c *ConfigCommands) DeletePlugin(pluginType string, name string) *ConfigCommands {
+	return c.add("delete-"+pluginType, name)
+}
+
+// AddRequestHandler adds a request handler, its name is the path it is registered at, e.g. /mypath.
+func (c *ConfigCommands) AddRequestHandler(handler PluginConfig) *ConfigCommands {
+	return c.AddPlugin(RequestHandlerPlugin, handler)
+}
+
+// UpdateRequestHandler replaces the definition of an existing request handler.
+func (c *ConfigCommands) UpdateRequestHandler(handler PluginConfig) *ConfigCommands {
+	return c.UpdatePlugin(RequestHandlerPlugin, handler)
+}
+
+// DeleteRequestHandler deletes a request handler by name.
+func (c *ConfigCommands) DeleteRequestHandler(name string) *ConfigCommands {
+	return c.DeletePlugin(RequestHandlerPlugin, name)
+}
+
+// AddSearchComponent adds a search component.
+func (c *ConfigCommands) AddSearchComponent(component PluginConfig) *ConfigCommands {
+	return c.AddPlugin(SearchComponentPlugin, component)
+}
+
+// UpdateSearchComponent replaces the definition of an existing search component.
+func (c *ConfigCommands) UpdateSearchComponent(component PluginConfig) *ConfigCommands {
+	return c.UpdatePlugin(SearchComponentPlugin, component)
+}
+
+// DeleteSearchComponent deletes a search component by name.
+func (c *ConfigCommands) DeleteSearchComponent(name string) *ConfigCommands {
+	return c.DeletePlugin(SearchComponentPlugin, name)
+}
+
+// Len returns the number of commands.
+func (c *ConfigCommands) Len() int {
+	return len(c.commands)
+}
+
+func (c *ConfigCommands) add(name string, body interface{}) *ConfigCommands {
+	c.commands.add(name, body)
+	return c
+}
+
+func (c ConfigCommands) MarshalJSON() ([]byte, error) {
+	return c.commands.MarshalJSON()
+}
+
+// Set creates or overwrites a parameter set.
+func (p *ParamsCommands) Set(name string, params map[string]interface{}) *ParamsCommands {
+	return p.add(SetParamsCommand, map[string]interface{}{name: params})
+}
+
+// Update merges the parameters into an existing parameter set.
+func (p *ParamsCommands) Update(name string, params map[string]interface{}) *ParamsCommands {
+	return p.add(UpdateParamsCommand, map[string]interface{}{name: params})
+}
+
+// Unset deletes parameter sets.
+func (p *ParamsCommands) Unset(names ...string) *ParamsCommands {
+	return p.add(UnsetParamsCommand, names)
+}
+
+// Len returns the number of commands.
+func (p *ParamsCommands) Len() int {
+	return len(p.commands)
+}
+
+func (p *ParamsCommands) add(name string, body interface{}) *ParamsCommands {
+	p.commands.add(name, body)
+	return p
+}
+
+func (p ParamsCommands) MarshalJSON() ([]byte, error) {
+	return p.commands.MarshalJSON()
+}
+
+// GET: Retrieve the configuration of a collection
+// The configuration is returned in Response.Config.
+func (c *CollectionConfigAPI) Get(ctx context.Context, collection string) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/config", collection)
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, configBase{WT: JSON}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// SECTION: Retrieve a section of the configuration, e.g. ConfigRequestHandler
+// The section is returned in Response.Config.
+func (c *CollectionConfigAPI) Section(ctx context.Context, collection string, section string, params ConfigSectionParameters) (*Response, error) {
+	params.WT = JSON
+
+	path := fmt.Sprintf("/solr/%s/config/%s", collection, section)
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// OVERLAY: Retrieve the changes made through the Config API
+// The overlay is returned in Response.Overlay.
+func (c *CollectionConfigAPI) Overlay(ctx context.Context, collection string) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/config/overlay", collection)
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, configBase{WT: JSON}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// MODIFY: Apply Config API commands
+// The failed commands are reported in Response.Error.Details.
+func (c *CollectionConfigAPI) Modify(ctx context.Context, collection string, commands ConfigCommands) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/config", collection)
+
+	req, err := c.client.NewRequest(ctx, http.MethodPost, path, commands, configBase{WT: JSON}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// PARAMS: List the parameter sets
+func (c *CollectionConfigAPI) Params(ctx context.Context, collection string) (*ParamsResponse, error) {
+	return c.params(ctx, fmt.Sprintf("/solr/%s/config/params", collection))
+}
+
+// PARAM SET: Retrieve a parameter set
+func (c *CollectionConfigAPI) ParamSet(ctx context.Context, collection string, name string) (*ParamsResponse, error) {
+	return c.params(ctx, fmt.Sprintf("/solr/%s/config/params/%s", collection, name))
+}
+
+func (c *CollectionConfigAPI) params(ctx context.Context, path string) (*ParamsResponse, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, configBase{WT: JSON}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := ParamsResponse{}
+	resp, err := c.client.do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+	response.HttpResponse = resp
+
+	return &response, nil
+}
+
+// MODIFY PARAMS: Apply Request Parameters API commands
+// The parameter sets are used by requests through the useParams parameter.
+func (c *CollectionConfigAPI) ModifyParams(ctx context.Context, collection string, commands ParamsCommands) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/config/params", collection)
+
+	req, err := c.client.NewRequest(ctx, http.MethodPost, path, commands, configBase{WT: JSON}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
@@ -0,0 +1,147 @@
+package solr
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestConfigCommandsMarshal(t *testing.T) {
+	commands := ConfigCommands{}
+	commands.
+		SetProperty("updateHandler.autoCommit.maxTime", 15000).
+		UnsetProperty("query.filterCache.size").
+		SetUserProperty("variable_name", "some_value").
+		AddRequestHandler(PluginConfig{
+			Name:       "/mypath",
+			Class:      "solr.DumpRequestHandler",
+			Defaults:   map[string]interface{}{"x": "y"},
+			UseParams:  "x",
+			Components: []string{"terms"},
+		}).
+		UpdateSearchComponent(PluginConfig{
+			Name:       "elevator",
+			Class:      "solr.QueryElevationComponent",
+			Attributes: map[string]interface{}{"queryFieldType": "string", "config-file": "elevate.xml"},
+		}).
+		DeletePlugin(QueryParserPlugin, "myparser")
+
+	if commands.Len() != 6 {
+		t.Errorf("failed to add config commands %d", commands.Len())
+	}
+
+	b, err := json.Marshal(commands)
+	if err != nil {
+		t.Fatalf("failed to marshal commands %v", err)
+	}
+
+	expected := `{"set-property":{"updateHandler.autoCommit.maxTime":15000},` +
+		`"unset-property":"query.filterCache.size",` +
+		`"set-user-property":{"variable_name":"some_value"},` +
+		`"add-requesthandler":{"name":"/mypath","class":"solr.DumpRequestHandler","useParams":"x","defaults":{"x":"y"},"components":["terms"]},` +
+		`"update-searchcomponent":{"class":"solr.QueryElevationComponent","config-file":"elevate.xml","name":"elevator","queryFieldType":"string"},` +
+		`"delete-queryparser":"myparser"}`
+	if string(b) != expected {
+		t.Errorf("failed to marshal commands %s", b)
+	}
+}
+
+func TestParamsCommandsMarshal(t *testing.T) {
+	commands := ParamsCommands{}
+	commands.
+		Set("myQueries", map[string]interface{}{"defType": "edismax", "rows": "5"}).
+		Update("myFacets", map[string]interface{}{"facet": "true"}).
+		Unset("old", "older")
+
+	b, err := json.Marshal(commands)
+	if err != nil {
+		t.Fatalf("failed to marshal commands %v", err)
+	}
+
+	expected := `{"set":{"myQueries":{"defType":"edismax","rows":"5"}},"update":{"myFacets":{"facet":"true"}},"unset":["old","older"]}`
+	if string(b) != expected {
+		t.Errorf("failed to marshal commands %s", b)
+	}
+}
+
+func TestConfigResponseDecode(t *testing.T) {
+	b := []byte(`{
+		"responseHeader": {"status": 0, "QTime": 2},
+		"config": {
+			"luceneMatchVersion": "org.apache.lucene.util.Version:8.5.1",
+			"updateHandler": {"class": "solr.DirectUpdateHandler2", "autoCommit": {"maxTime": 15000}},
+			"requestHandler": {
+				"/select": {"name": "/select", "class": "solr.SearchHandler", "defaults": {"echoParams": "explicit", "rows": 10}},
+				"/mypath": {"name": "/mypath", "class": "solr.DumpRequestHandler", "useParams": "x", "last-components": ["spellcheck"]}
+			},
+			"znodeVersion": 3,
+			"circuitBreaker": {"enabled": false}
+		},
+		"overlay": {
+			"znodeVersion": 3,
+			"props": {"updateHandler": {"autoCommit": {"maxTime": 15000}}},
+			"userProps": {"variable_name": "some_value"}
+		}
+	}`)
+
+	var response Response
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatalf("failed to decode config response %v", err)
+	}
+
+	config := response.Config
+	if config.ZNodeVersion != 3 || config.LuceneMatchVersion != "org.apache.lucene.util.Version:8.5.1" {
+		t.Errorf("failed to decode config %+v", config)
+	}
+	if handler := config.RequestHandler["/select"]; handler.Class != "solr.SearchHandler" || handler.Defaults["echoParams"] != "explicit" {
+		t.Errorf("failed to decode /select handler %+v", handler)
+	}
+	if handler := config.RequestHandler["/mypath"]; handler.UseParams != "x" || len(handler.LastComponents) != 1 {
+		t.Errorf("failed to decode /mypath handler %+v", handler)
+	}
+	if _, ok := config.Attributes["circuitBreaker"]; !ok {
+		t.Errorf("failed to decode circuitBreaker attribute %v", config.Attributes)
+	}
+
+	if response.Overlay.ZNodeVersion != 3 || response.Overlay.UserProps["variable_name"] != "some_value" {
+		t.Errorf("failed to decode overlay %+v", response.Overlay)
+	}
+}
+
+func TestParamsResponseDecode(t *testing.T) {
+	b := []byte(`{
+		"responseHeader": {"status": 0, "QTime": 1},
+		"response": {
+			"znodeVersion": 4,
+			"params": {
+				"myQueries": {"defType": "edismax", "rows": "5", "": {"v": 2}}
+			}
+		}
+	}`)
+
+	var response ParamsResponse
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatalf("failed to decode params response %v", err)
+	}
+
+	if response.Response.ZNodeVersion != 4 {
+		t.Errorf("failed to decode params version %d", response.Response.ZNodeVersion)
+	}
+
+	set := response.Response.Params["myQueries"]
+	if set.Version != 2 || len(set.Params) != 2 || set.Params["defType"] != "edismax" {
+		t.Errorf("failed to decode param set %v", set)
+	}
+}
+
+func TestUseParamsEncode(t *testing.T) {
+	values, err := query.Values(SelectParameters{Query: "*:*", UseParams: []string{"myQueries", "myFacets"}})
+	if err != nil {
+		t.Errorf("failed to encode useParams %v", err)
+	}
+
+	if values.Get("useParams") != "myQueries,myFacets" {
+		t.Errorf("failed to encode useParams %q", values.Get("useParams"))
+	}
+}
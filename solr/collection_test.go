@@ -14,7 +14,6 @@ func TestCollectionCreate(t *testing.T) {
 		NumShards:            1,
 		ReplicationFactor:    1,
 		CollectionConfigName: "_default",
-		Async:                false,
 	})
 	if err != nil {
 		t.Errorf("failed to create collection %v", err)
@@ -29,8 +28,7 @@ func TestCollectionReload(t *testing.T) {
 	client := NewClient()
 
 	response, err := client.Collection.Reload(context.Background(), CollectionReload{
-		Name:  "tests",
-		Async: false,
+		Name: "tests",
 	})
 	if err != nil {
 		t.Errorf("failed to reload collection %v", err)
@@ -111,7 +109,6 @@ func TestCollectionMigrate(t *testing.T) {
 		NumShards:            1,
 		ReplicationFactor:    1,
 		CollectionConfigName: "_default",
-		Async:                false,
 	})
 	if err != nil {
 		t.Errorf("failed to create collection tests-migrate %v", err)
@@ -126,7 +123,6 @@ func TestCollectionMigrate(t *testing.T) {
 		TargetCollection: "tests-migrate",
 		SplitKey:         "a!",
 		ForwardTimeout:   100000,
-		Async:            false,
 	})
 	if err != nil {
 		t.Errorf("failed to migrate collection %v", err)
@@ -149,7 +145,6 @@ func TestCollectionMigrate(t *testing.T) {
 //		Collection:     "tests",
 //		Name:           backupFilePath,
 //		Location:       "/tmp",
-//		Async:          false,
 //	})
 //
 //	if err != nil {
@@ -172,7 +167,6 @@ func TestCollectionMigrate(t *testing.T) {
 //		Collection:     "tests",
 //		Name:           backupFilePath,
 //		Location:       "/tmp/",
-//		Async:          false,
 //	})
 //	if err != nil {
 //		t.Errorf("failed to backup collection %v", err)
@@ -186,7 +180,6 @@ func TestCollectionMigrate(t *testing.T) {
 //		Collection:           backupFilePath,
 //		Name:                 backupFilePath,
 //		Location:       	  "/tmp/",
-//		Async:                false,
 //		ReplicationFactor:    1,
 //	})
 //	if err != nil {
@@ -202,8 +195,7 @@ func TestCollectionDelete(t *testing.T) {
 	client := NewClient()
 
 	response, err := client.Collection.Delete(context.Background(), CollectionDelete{
-		Name:  "tests",
-		Async: false,
+		Name: "tests",
 	})
 	if err != nil {
 		t.Errorf("failed to delete collection %v", err)
@@ -218,8 +210,7 @@ func TestCollectionDeleteMigrate(t *testing.T) {
 	client := NewClient()
 
 	response, err := client.Collection.Delete(context.Background(), CollectionDelete{
-		Name:  "tests-migrate",
-		Async: false,
+		Name: "tests-migrate",
 	})
 	if err != nil {
 		t.Errorf("failed to delete collection migrate %v", err)
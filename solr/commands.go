@@ -0,0 +1,48 @@
+package solr
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Commands of the JSON APIs accepting several commands in a single object, e.g. the Schema
+// and Config APIs. The same command may be repeated, the commands are sent in order.
+type commandList []namedCommand
+
+type namedCommand struct {
+	name string
+	body interface{}
+}
+
+func (l *commandList) add(name string, body interface{}) {
+	*l = append(*l, namedCommand{name: name, body: body})
+}
+
+// MarshalJSON encodes the commands as a single object, repeating the command names.
+func (l commandList) MarshalJSON() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	buf.WriteString("{")
+	for i, command := range l {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+
+		name, err := json.Marshal(command.name)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := json.Marshal(command.body)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(name)
+		buf.WriteString(":")
+		buf.Write(body)
+	}
+	buf.WriteString("}")
+
+	return buf.Bytes(), nil
+}
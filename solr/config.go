@@ -1,9 +1,14 @@
 package solr
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 )
 
 const (
@@ -11,7 +16,7 @@ const (
 )
 
 type Config struct {
-	Name                   string `json:"name,omitempty"url:"name,omitempty"`
+	Name                   string `json:"name,omitempty" url:"name,omitempty"`
 	BaseConfigSet          string `json:"baseConfigSet,omitempty"`
 	ConfigSetPropImmutable bool   `json:"configSetProp.immutable,omitempty"`
 }
@@ -22,6 +27,27 @@ type ConfigParameter struct {
 	OmitHeader bool   `url:"omitHeader,omitempty"`
 }
 
+type UploadConfigOptions struct {
+	// If true, files of an existing configset are overwritten, otherwise uploading a configset
+	// that already exists fails.
+	Overwrite bool `url:"overwrite,omitempty"`
+
+	// If true, the files of an existing configset missing from the upload are deleted. Only
+	// used when overwriting a configset with a zip file.
+	Cleanup bool `url:"cleanup,omitempty"`
+}
+
+type uploadConfigParameter struct {
+	ConfigParameter
+	UploadConfigOptions
+
+	// The path of the uploaded file in the configset, when uploading a single file.
+	FilePath string `url:"filePath,omitempty"`
+}
+
+// The schema file names of a configset, one of them is required by ValidateConfigDir.
+var SchemaFileNames = []string{"managed-schema", "managed-schema.xml", "schema.xml"}
+
 type ConfigAPI struct {
 	client *Client
 }
@@ -77,7 +103,7 @@ func (c *ConfigAPI) Create(ctx context.Context, config CreateConfig) (*Response,
 	return response, err
 }
 
-// DELETE: Create a Configset
+// DELETE: Delete a Configset
 func (c *ConfigAPI) Delete(ctx context.Context, name string) (*Response, error) {
 	path := fmt.Sprintf("/api/cluster/configs/%s", name)
 
@@ -95,3 +121,124 @@ func (c *ConfigAPI) Delete(ctx context.Context, name string) (*Response, error)
 
 	return response, err
 }
+
+// UPLOAD DIR: Upload a Configset from a local directory
+// The directory is validated with ValidateConfigDir and zipped in memory before being uploaded.
+func (c *ConfigAPI) UploadDir(ctx context.Context, dir string, name string, opts UploadConfigOptions) (*Response, error) {
+	if err := ValidateConfigDir(dir); err != nil {
+		return nil, err
+	}
+
+	body, err := zipConfigDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.upload(ctx, body, uploadConfigParameter{
+		ConfigParameter:     ConfigParameter{Action: ActionUpload, Name: name},
+		UploadConfigOptions: opts,
+	})
+}
+
+// UPLOAD FILE: Upload a single file to a Configset
+// The local file filename is stored at filePath in the configset, e.g. lang/stopwords_en.txt.
+func (c *ConfigAPI) UploadFile(ctx context.Context, filename string, name string, filePath string, opts UploadConfigOptions) (*Response, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return c.upload(ctx, file, uploadConfigParameter{
+		ConfigParameter:     ConfigParameter{Action: ActionUpload, Name: name},
+		UploadConfigOptions: opts,
+		FilePath:            filePath,
+	})
+}
+
+func (c *ConfigAPI) upload(ctx context.Context, body io.Reader, params uploadConfigParameter) (*Response, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodPost, "/solr/admin/configs", body, params, &map[string]string{
+		"Content-Type": "application/octet-stream",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// ValidateConfigDir checks that dir is a configset directory, holding solrconfig.xml and a schema
+// file at its root.
+func ValidateConfigDir(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("solr: configset %s is not a directory", dir)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "solrconfig.xml")); err != nil {
+		return fmt.Errorf("solr: configset %s has no solrconfig.xml: %v", dir, err)
+	}
+
+	for _, name := range SchemaFileNames {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("solr: configset %s has no schema file, expected one of %v", dir, SchemaFileNames)
+}
+
+// zipConfigDir zips the files of dir, named by their path relative to dir.
+func zipConfigDir(dir string) (*bytes.Buffer, error) {
+	buf := new(bytes.Buffer)
+	archive := zip.NewWriter(buf)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(name)
+		header.Method = zip.Deflate
+
+		w, err := archive.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(w, file)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
@@ -1,7 +1,13 @@
 package solr
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -41,3 +47,97 @@ func TestDeleteConfig2(t *testing.T) {
 		t.Errorf("failed to delete config %v", err)
 	}
 }
+
+func TestUploadConfigDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "configset")
+	if err != nil {
+		t.Fatalf("failed to create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"solrconfig.xml":        "<config/>",
+		"managed-schema":        "<schema/>",
+		"lang/stopwords_en.txt": "a\nan\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create config dir %v", err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write config file %v", err)
+		}
+	}
+
+	var query string
+	var body []byte
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		body, _ = ioutil.ReadAll(r.Body)
+		_, _ = w.Write([]byte(`{"responseHeader": {"status": 0, "QTime": 12}}`))
+	})
+	defer server.Close()
+
+	_, err = client.Config.UploadDir(context.Background(), dir, "identify-events", UploadConfigOptions{Overwrite: true, Cleanup: true})
+	if err != nil {
+		t.Fatalf("failed to upload config dir %v", err)
+	}
+
+	if query != "action=UPLOAD&cleanup=true&name=identify-events&overwrite=true" {
+		t.Errorf("failed to upload config dir %v", query)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		t.Fatalf("failed to read uploaded zip %v", err)
+	}
+	if len(archive.File) != len(files) {
+		t.Fatalf("failed to zip config files %v", len(archive.File))
+	}
+	for _, file := range archive.File {
+		r, err := file.Open()
+		if err != nil {
+			t.Fatalf("failed to open zipped file %v", err)
+		}
+		content, _ := ioutil.ReadAll(r)
+		r.Close()
+		if files[file.Name] != string(content) {
+			t.Errorf("failed to zip content %q of %v", content, file.Name)
+		}
+	}
+
+	_, err = client.Config.UploadFile(context.Background(), filepath.Join(dir, "managed-schema"), "identify-events", "managed-schema", UploadConfigOptions{Overwrite: true})
+	if err != nil {
+		t.Fatalf("failed to upload config file %v", err)
+	}
+	if query != "action=UPLOAD&filePath=managed-schema&name=identify-events&overwrite=true" || string(body) != "<schema/>" {
+		t.Errorf("failed to upload config file %v %q", query, body)
+	}
+}
+
+func TestValidateConfigDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "configset")
+	if err != nil {
+		t.Fatalf("failed to create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ValidateConfigDir(dir); err == nil {
+		t.Error("failed to reject a config dir without solrconfig.xml")
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "solrconfig.xml"), []byte("<config/>"), 0644); err != nil {
+		t.Fatalf("failed to write solrconfig.xml %v", err)
+	}
+	if err := ValidateConfigDir(dir); err == nil {
+		t.Error("failed to reject a config dir without a schema file")
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "schema.xml"), []byte("<schema/>"), 0644); err != nil {
+		t.Fatalf("failed to write schema.xml %v", err)
+	}
+	if err := ValidateConfigDir(dir); err != nil {
+		t.Errorf("failed to validate config dir %v", err)
+	}
+}
@@ -0,0 +1,320 @@
+// https://lucene.apache.org/solr/guide/8_5/configsets-api.html
+package solr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The ZooKeeper node holding the configsets.
+const ConfigSetsZNode = "/configs"
+
+type ZooKeeperParameters struct {
+	WT WT `url:"wt,omitempty"`
+
+	// The path of the znode, e.g. /configs/identify-events.
+	Path string `url:"path,omitempty"`
+
+	// If true, the data of the znode is returned in Response.ZNode, otherwise the tree of its
+	// children is returned in Response.Tree.
+	Detail bool `url:"detail,omitempty"`
+
+	// If true, the properties and data of each znode of the tree are returned in ZNodeTree.ZNode.
+	Dump bool `url:"dump,omitempty"`
+}
+
+// A node of the ZooKeeper tree returned by the ZooKeeper file browsing handler.
+type ZNodeTree struct {
+	Text     string      `json:"text"`
+	Attr     ZNodeAttr   `json:"a_attr"`
+	Children []ZNodeTree `json:"children,omitempty"`
+
+	// The properties and data of the znode, only returned when the tree is requested with Dump.
+	ZNode *ZNode `json:"znode,omitempty"`
+}
+
+type ZNodeAttr struct {
+	// The link to the details of the znode, holding its path.
+	Href string `json:"href"`
+}
+
+type ZNode struct {
+	Path string                 `json:"path"`
+	Prop map[string]interface{} `json:"prop,omitempty"`
+
+	// The data of the znode, the content of a configset file.
+	Data string `json:"data,omitempty"`
+}
+
+// The differences between a local configset directory and a deployed configset, as paths
+// relative to the configset root sorted by name.
+type ConfigSetDiff struct {
+	// Files only found in the local directory.
+	LocalOnly []string
+
+	// Files only found in the deployed configset.
+	RemoteOnly []string
+
+	// Files found on both sides with a different content.
+	Changed []string
+}
+
+// Returned by ConfigAPI.DeleteUnused when collections still use the configset.
+type ConfigSetInUseError struct {
+	Name        string
+	Collections []string
+}
+
+func (e *ConfigSetInUseError) Error() string {
+	return fmt.Sprintf("solr: configset %s is used by collections %s", e.Name, strings.Join(e.Collections, ", "))
+}
+
+// Path returns the path of the znode, read from its link.
+func (t ZNodeTree) Path() string {
+	i := strings.Index(t.Attr.Href, "?")
+	if i < 0 {
+		return ""
+	}
+
+	values, err := url.ParseQuery(t.Attr.Href[i+1:])
+	if err != nil {
+		return ""
+	}
+
+	return values.Get("path")
+}
+
+// IsFile reports whether the znode holds a file, a znode without children holding data. Empty
+// znodes are taken as directories when the tree is requested with Dump, empty files being
+// indistinguishable from empty directories.
+func (t ZNodeTree) IsFile() bool {
+	if len(t.Children) > 0 {
+		return false
+	}
+	if t.ZNode == nil {
+		return true
+	}
+
+	return t.ZNode.propInt("children_count") == 0 && (t.ZNode.propInt("dataLength") > 0 || t.ZNode.Data != "")
+}
+
+// propInt returns a numeric property of the znode, 0 when missing.
+func (z *ZNode) propInt(name string) int {
+	switch value := z.Prop[name].(type) {
+	case float64:
+		return int(value)
+	case string:
+		n, _ := strconv.Atoi(value)
+		return n
+	}
+
+	return 0
+}
+
+// Equal reports whether the local and deployed configsets hold the same files.
+func (d *ConfigSetDiff) Equal() bool {
+	return len(d.LocalOnly) == 0 && len(d.RemoteOnly) == 0 && len(d.Changed) == 0
+}
+
+// ZOOKEEPER: Browse the ZooKeeper tree
+func (c *ConfigAPI) ZooKeeper(ctx context.Context, params ZooKeeperParameters) (*Response, error) {
+	params.WT = JSON
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/zookeeper", nil, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// FILES: List the files of a Configset
+// The paths are relative to the configset root, e.g. lang/stopwords_en.txt. Empty znodes without
+// children are taken as directories and not listed.
+func (c *ConfigAPI) Files(ctx context.Context, name string) ([]string, error) {
+	root := ConfigSetsZNode + "/" + name
+
+	response, err := c.ZooKeeper(ctx, ZooKeeperParameters{Path: root, Dump: true})
+	if err != nil {
+		return nil, err
+	}
+	if err := response.Err(); err != nil {
+		return nil, err
+	}
+
+	var files []string
+	var walk func(nodes []ZNodeTree)
+	walk = func(nodes []ZNodeTree) {
+		for _, node := range nodes {
+			if !node.IsFile() {
+				walk(node.Children)
+				continue
+			}
+
+			path := node.Path()
+			if strings.HasPrefix(path, root+"/") {
+				files = append(files, strings.TrimPrefix(path, root+"/"))
+			}
+		}
+	}
+	walk(response.Tree)
+
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// FILE: Fetch a file of a Configset
+// The content is read as text from the znode data, binary files may not be preserved.
+func (c *ConfigAPI) File(ctx context.Context, name string, path string) ([]byte, error) {
+	response, err := c.ZooKeeper(ctx, ZooKeeperParameters{
+		Path:   ConfigSetsZNode + "/" + name + "/" + path,
+		Detail: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := response.Err(); err != nil {
+		return nil, err
+	}
+
+	return []byte(response.ZNode.Data), nil
+}
+
+// FETCH: Fetch all the files of a Configset, by path relative to the configset root
+func (c *ConfigAPI) Fetch(ctx context.Context, name string) (map[string][]byte, error) {
+	files, err := c.Files(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	contents := make(map[string][]byte, len(files))
+	for _, file := range files {
+		content, err := c.File(ctx, name, file)
+		if err != nil {
+			return nil, err
+		}
+		contents[file] = content
+	}
+
+	return contents, nil
+}
+
+// COMPARE DIR: Compare a local configset directory with a deployed Configset, file by file
+func (c *ConfigAPI) CompareDir(ctx context.Context, dir string, name string) (*ConfigSetDiff, error) {
+	local, err := readConfigDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	remote, err := c.Fetch(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffConfigSets(local, remote), nil
+}
+
+// COLLECTIONS: List the collections using a Configset
+// The configset of each collection is read from the cluster status.
+func (c *ConfigAPI) Collections(ctx context.Context, name string) ([]string, error) {
+	response, err := c.client.Collection.ClusterStatus(ctx, CollectionClusterStatus{})
+	if err != nil {
+		return nil, err
+	}
+	if err := response.Err(); err != nil {
+		return nil, err
+	}
+
+	var collections []string
+	for collection, state := range response.Cluster.Collections {
+		if state.ConfigName == name {
+			collections = append(collections, collection)
+		}
+	}
+	sort.Strings(collections)
+
+	return collections, nil
+}
+
+// DELETE UNUSED: Delete a Configset no collection uses
+// A *ConfigSetInUseError listing the collections using the configset is returned otherwise.
+func (c *ConfigAPI) DeleteUnused(ctx context.Context, name string) (*Response, error) {
+	collections, err := c.Collections(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(collections) > 0 {
+		return nil, &ConfigSetInUseError{Name: name, Collections: collections}
+	}
+
+	return c.Delete(ctx, name)
+}
+
+// readConfigDir reads the files of dir, by path relative to dir.
+func readConfigDir(dir string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(name)] = content
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func diffConfigSets(local map[string][]byte, remote map[string][]byte) *ConfigSetDiff {
+	diff := &ConfigSetDiff{}
+
+	for name, content := range local {
+		remoteContent, ok := remote[name]
+		switch {
+		case !ok:
+			diff.LocalOnly = append(diff.LocalOnly, name)
+		case !bytes.Equal(content, remoteContent):
+			diff.Changed = append(diff.Changed, name)
+		}
+	}
+	for name := range remote {
+		if _, ok := local[name]; !ok {
+			diff.RemoteOnly = append(diff.RemoteOnly, name)
+		}
+	}
+
+	sort.Strings(diff.LocalOnly)
+	sort.Strings(diff.RemoteOnly)
+	sort.Strings(diff.Changed)
+
+	return diff
+}
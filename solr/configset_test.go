@@ -0,0 +1,169 @@
+package solr
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// configSetNode returns a node of a dumped ZooKeeper tree.
+func configSetNode(path string, data string, childrenCount int, children ...ZNodeTree) ZNodeTree {
+	return ZNodeTree{
+		Text:     path[strings.LastIndex(path, "/")+1:],
+		Attr:     ZNodeAttr{Href: "admin/zookeeper?detail=true&path=" + url.QueryEscape(path)},
+		Children: children,
+		ZNode: &ZNode{Path: path, Data: data, Prop: map[string]interface{}{
+			"children_count": childrenCount,
+			"dataLength":     len(data),
+		}},
+	}
+}
+
+func newConfigSetClient(t *testing.T, deleted *bool) (*Client, *httptest.Server) {
+	files := map[string]string{
+		"/configs/events/solrconfig.xml":        "<config/>",
+		"/configs/events/managed-schema":        "<schema version=\"1.6\"/>",
+		"/configs/events/lang/stopwords_en.txt": "a\nan\n",
+	}
+
+	return newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/solr/admin/zookeeper":
+			path := r.URL.Query().Get("path")
+			if r.URL.Query().Get("detail") == "true" {
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"znode": ZNode{Path: path, Prop: map[string]interface{}{"version": 0}, Data: files[path]},
+				})
+				return
+			}
+			if r.URL.Query().Get("dump") != "true" {
+				t.Errorf("failed to request the znode properties %v", r.URL)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"tree": []ZNodeTree{
+				configSetNode("/configs/events", "", 3,
+					configSetNode("/configs/events/lang", "", 1, configSetNode("/configs/events/lang/stopwords_en.txt", files["/configs/events/lang/stopwords_en.txt"], 0)),
+					configSetNode("/configs/events/managed-schema", files["/configs/events/managed-schema"], 0),
+					configSetNode("/configs/events/solrconfig.xml", files["/configs/events/solrconfig.xml"], 0),
+					configSetNode("/configs/events/velocity", "", 0),
+				),
+			}})
+		case "/solr/admin/collections":
+			if r.URL.Query().Get("action") != "CLUSTERSTATUS" {
+				t.Errorf("failed to request cluster status %v", r.URL)
+			}
+			writeClusterStatus(w, ClusterState{Collections: map[string]CollectionState{
+				"events_b": {ConfigName: "events"},
+				"events_a": {ConfigName: "events"},
+				"logs":     {ConfigName: "logs"},
+			}})
+		case "/api/cluster/configs/unused":
+			*deleted = true
+			_, _ = w.Write([]byte(`{"responseHeader": {"status": 0}}`))
+		default:
+			t.Errorf("failed to route request %v", r.URL)
+		}
+	})
+}
+
+func TestConfigSetFiles(t *testing.T) {
+	var deleted bool
+	client, server := newConfigSetClient(t, &deleted)
+	defer server.Close()
+
+	files, err := client.Config.Files(context.Background(), "events")
+	if err != nil {
+		t.Fatalf("failed to list configset files %v", err)
+	}
+
+	expected := []string{"lang/stopwords_en.txt", "managed-schema", "solrconfig.xml"}
+	if !reflect.DeepEqual(files, expected) {
+		t.Errorf("failed to list configset files %v", files)
+	}
+
+	content, err := client.Config.File(context.Background(), "events", "lang/stopwords_en.txt")
+	if err != nil {
+		t.Fatalf("failed to fetch configset file %v", err)
+	}
+	if string(content) != "a\nan\n" {
+		t.Errorf("failed to fetch configset file %q", content)
+	}
+}
+
+func TestConfigSetCompareDir(t *testing.T) {
+	var deleted bool
+	client, server := newConfigSetClient(t, &deleted)
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "configset")
+	if err != nil {
+		t.Fatalf("failed to create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	for name, content := range map[string]string{
+		"solrconfig.xml": "<config/>",
+		"managed-schema": "<schema version=\"1.7\"/>",
+		"synonyms.txt":   "tv,television\n",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write configset file %v", err)
+		}
+	}
+
+	diff, err := client.Config.CompareDir(context.Background(), dir, "events")
+	if err != nil {
+		t.Fatalf("failed to compare configset %v", err)
+	}
+
+	if diff.Equal() {
+		t.Error("failed to find configset differences")
+	}
+	if !reflect.DeepEqual(diff.LocalOnly, []string{"synonyms.txt"}) {
+		t.Errorf("failed to find local only files %v", diff.LocalOnly)
+	}
+	if !reflect.DeepEqual(diff.RemoteOnly, []string{"lang/stopwords_en.txt"}) {
+		t.Errorf("failed to find remote only files %v", diff.RemoteOnly)
+	}
+	if !reflect.DeepEqual(diff.Changed, []string{"managed-schema"}) {
+		t.Errorf("failed to find changed files %v", diff.Changed)
+	}
+}
+
+func TestConfigSetDeleteInUse(t *testing.T) {
+	var deleted bool
+	client, server := newConfigSetClient(t, &deleted)
+	defer server.Close()
+
+	collections, err := client.Config.Collections(context.Background(), "events")
+	if err != nil {
+		t.Fatalf("failed to list collections %v", err)
+	}
+	if !reflect.DeepEqual(collections, []string{"events_a", "events_b"}) {
+		t.Errorf("failed to list collections %v", collections)
+	}
+
+	_, err = client.Config.DeleteUnused(context.Background(), "events")
+	inUse, ok := err.(*ConfigSetInUseError)
+	if !ok {
+		t.Fatalf("failed to refuse deleting a configset in use %v", err)
+	}
+	if !reflect.DeepEqual(inUse.Collections, []string{"events_a", "events_b"}) {
+		t.Errorf("failed to list collections using the configset %v", inUse.Collections)
+	}
+
+	_, err = client.Config.DeleteUnused(context.Background(), "unused")
+	if err != nil {
+		t.Fatalf("failed to delete config %v", err)
+	}
+	if !deleted {
+		t.Error("failed to delete unused config")
+	}
+}
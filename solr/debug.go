@@ -0,0 +1,180 @@
+// https://lucene.apache.org/solr/guide/8_5/common-query-parameters.html#debug-parameter
+package solr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type DebugType string
+
+const (
+	DebugQuery   DebugType = "query"
+	DebugTiming  DebugType = "timing"
+	DebugResults DebugType = "results"
+	DebugAll     DebugType = "all"
+)
+
+type DebugParameters struct {
+	// The kinds of debug information to include in the response, e.g. query, timing or results.
+	// The parameter can be given multiple times, all returns every kind of debug information.
+	Debug []DebugType `url:"debug,omitempty"`
+
+	// If true, the explain output of each document is returned as a nested structure instead
+	// of the plain text produced by Lucene.
+	ExplainStructured bool `url:"debug.explain.structured,omitempty"`
+
+	// A Lucene query identifying a set of documents to be explained in addition to the results,
+	// useful to understand why a document is not ranked higher.
+	ExplainOther string `url:"explainOther,omitempty"`
+}
+
+type DebugInfo struct {
+	RawQueryString      string                 `json:"rawquerystring,omitempty"`
+	QueryString         string                 `json:"querystring,omitempty"`
+	ParsedQuery         string                 `json:"parsedquery,omitempty"`
+	ParsedQueryToString string                 `json:"parsedquery_toString,omitempty"`
+	QParser             string                 `json:"QParser,omitempty"`
+	AltQueryString      string                 `json:"altquerystring,omitempty"`
+	BoostFuncs          []string               `json:"boostfuncs,omitempty"`
+	FilterQueries       []string               `json:"filter_queries,omitempty"`
+	ParsedFilterQueries []string               `json:"parsed_filter_queries,omitempty"`
+	Explain             map[string]Explanation `json:"explain,omitempty"`
+	OtherQuery          string                 `json:"otherQuery,omitempty"`
+	ExplainOther        map[string]Explanation `json:"explainOther,omitempty"`
+	Timing              Timing                 `json:"timing,omitempty"`
+}
+
+type Timing struct {
+	Time    float64     `json:"time,omitempty"`
+	Prepare PhaseTiming `json:"prepare,omitempty"`
+	Process PhaseTiming `json:"process,omitempty"`
+}
+
+// Time spent by a request phase, in milliseconds, in total and per search component.
+type PhaseTiming struct {
+	Time       float64
+	Components map[string]float64
+}
+
+// A node of the score explanation tree of a document, the value of each node is computed
+// from the values of its details.
+type Explanation struct {
+	Match       bool          `json:"match"`
+	Value       float64       `json:"value"`
+	Description string        `json:"description,omitempty"`
+	Details     []Explanation `json:"details,omitempty"`
+}
+
+func (p *PhaseTiming) UnmarshalJSON(b []byte) error {
+	var timings map[string]json.RawMessage
+	if err := json.Unmarshal(b, &timings); err != nil {
+		return err
+	}
+
+	p.Components = make(map[string]float64, len(timings))
+	for name, raw := range timings {
+		if name == "time" {
+			if err := json.Unmarshal(raw, &p.Time); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var component struct {
+			Time float64 `json:"time"`
+		}
+		if err := json.Unmarshal(raw, &component); err != nil {
+			return err
+		}
+		p.Components[name] = component.Time
+	}
+
+	return nil
+}
+
+func (e *Explanation) UnmarshalJSON(b []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")) {
+		type explanation Explanation
+
+		return json.Unmarshal(b, (*explanation)(e))
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	explanation, err := ParseExplanation(s)
+	if err != nil {
+		return err
+	}
+	*e = explanation
+
+	return nil
+}
+
+// The prefix of the description of a clause that doesn't match in the plain text explain output.
+const nonMatchPrefix = "(NON-MATCH) "
+
+// ParseExplanation parses the plain text explain output of a document into a tree, each
+// line holding "<value> = <description>" indented two spaces deeper than its parent. Clauses
+// prefixed with (NON-MATCH) or with no match on a required clause don't match.
+func ParseExplanation(s string) (Explanation, error) {
+	var root *Explanation
+	var stack []*Explanation
+	var indents []int
+
+	for _, line := range strings.Split(s, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		parts := strings.SplitN(strings.TrimSpace(line), " = ", 2)
+		if len(parts) != 2 {
+			return Explanation{}, fmt.Errorf("solr: invalid explain line %q", line)
+		}
+
+		value, err := strconv.ParseFloat(parts[0], 64)
+		if err != nil {
+			return Explanation{}, fmt.Errorf("solr: invalid explain value in %q: %v", line, err)
+		}
+
+		description := strings.TrimPrefix(parts[1], nonMatchPrefix)
+		node := Explanation{
+			Match:       description == parts[1] && !strings.HasPrefix(description, "no match on required clause"),
+			Value:       value,
+			Description: description,
+		}
+
+		for len(indents) > 0 && indents[len(indents)-1] >= indent {
+			stack = stack[:len(stack)-1]
+			indents = indents[:len(indents)-1]
+		}
+
+		if len(stack) == 0 {
+			if root != nil {
+				return Explanation{}, fmt.Errorf("solr: multiple explain roots in %q", line)
+			}
+			root = &node
+			stack = append(stack, root)
+			indents = append(indents, indent)
+			continue
+		}
+
+		parent := stack[len(stack)-1]
+		parent.Details = append(parent.Details, node)
+		stack = append(stack, &parent.Details[len(parent.Details)-1])
+		indents = append(indents, indent)
+	}
+
+	if root == nil {
+		return Explanation{}, nil
+	}
+
+	return *root, nil
+}
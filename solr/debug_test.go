@@ -0,0 +1,104 @@
+package solr
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDebugResponseDecode(t *testing.T) {
+	b := []byte(`{
+		"responseHeader": {"status": 0, "QTime": 4},
+		"response": {"numFound": 1, "start": 0, "docs": [{"id": "1"}]},
+		"debug": {
+			"rawquerystring": "title:solr",
+			"querystring": "title:solr",
+			"parsedquery": "title:solr",
+			"parsedquery_toString": "title:solr",
+			"explain": {
+				"1": "\n1.6943598 = weight(title:solr in 0) [SchemaSimilarity], result of:\n  1.6943598 = score(freq=1.0), product of:\n    2.2 = boost\n    0.7701635 = idf, computed as log(1 + (N - n + 0.5) / (n + 0.5)) from:\n      1 = n, number of documents containing term\n      2 = N, total number of documents with field\n    1.0 = tf, computed as freq / (freq + k1 * (1 - b + b * dl / avgdl)) from:\n      1.0 = freq, occurrences of term within document\n"
+			},
+			"QParser": "LuceneQParser",
+			"filter_queries": ["inStock:true"],
+			"parsed_filter_queries": ["inStock:T"],
+			"timing": {
+				"time": 2.0,
+				"prepare": {"time": 0.0, "query": {"time": 0.0}, "facet": {"time": 0.0}},
+				"process": {"time": 2.0, "query": {"time": 1.0}, "debug": {"time": 1.0}}
+			}
+		}
+	}`)
+
+	var response Response
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatalf("failed to decode debug response %v", err)
+	}
+
+	debug := response.Debug
+	if debug.ParsedQuery != "title:solr" || debug.QParser != "LuceneQParser" || debug.ParsedFilterQueries[0] != "inStock:T" {
+		t.Errorf("failed to decode debug info %v", debug)
+	}
+
+	if debug.Timing.Time != 2 || debug.Timing.Process.Components["query"] != 1 || len(debug.Timing.Prepare.Components) != 2 {
+		t.Errorf("failed to decode timing %v", debug.Timing)
+	}
+
+	explain := debug.Explain["1"]
+	if explain.Value != 1.6943598 || explain.Description != "weight(title:solr in 0) [SchemaSimilarity], result of:" || len(explain.Details) != 1 {
+		t.Fatalf("failed to decode explain root %v", explain)
+	}
+
+	score := explain.Details[0]
+	if len(score.Details) != 3 || score.Details[0].Value != 2.2 || score.Details[0].Description != "boost" {
+		t.Fatalf("failed to decode score explanation %v", score)
+	}
+
+	idf := score.Details[1]
+	if len(idf.Details) != 2 || idf.Details[1].Value != 2 || idf.Details[1].Description != "N, total number of documents with field" {
+		t.Errorf("failed to decode idf explanation %v", idf)
+	}
+
+	if tf := score.Details[2]; len(tf.Details) != 1 || tf.Details[0].Value != 1 {
+		t.Errorf("failed to decode tf explanation %v", tf)
+	}
+}
+
+func TestStructuredExplanationDecode(t *testing.T) {
+	var explain Explanation
+	err := json.Unmarshal([]byte(`{
+		"match": true, "value": 1.5, "description": "sum of:",
+		"details": [{"match": true, "value": 1.0, "description": "weight(a)"}, {"match": true, "value": 0.5, "description": "weight(b)"}]
+	}`), &explain)
+	if err != nil {
+		t.Fatalf("failed to decode structured explanation %v", err)
+	}
+
+	if !explain.Match || explain.Value != 1.5 || len(explain.Details) != 2 || explain.Details[1].Description != "weight(b)" {
+		t.Errorf("failed to decode structured explanation %v", explain)
+	}
+}
+
+func TestParseExplanationNonMatch(t *testing.T) {
+	explain, err := ParseExplanation("0.0 = (NON-MATCH) Failure to meet condition(s) of required/prohibited clause(s)\n" +
+		"  0.0 = no match on required clause (title:lucene)\n" +
+		"    0.0 = (NON-MATCH) no matching term\n" +
+		"  1.2 = weight(title:solr in 0) [SchemaSimilarity], result of:\n")
+	if err != nil {
+		t.Fatalf("failed to parse explanation %v", err)
+	}
+
+	if explain.Match || explain.Description != "Failure to meet condition(s) of required/prohibited clause(s)" || len(explain.Details) != 2 {
+		t.Fatalf("failed to parse non matching explanation %v", explain)
+	}
+	if required := explain.Details[0]; required.Match || len(required.Details) != 1 || required.Details[0].Match || required.Details[0].Description != "no matching term" {
+		t.Errorf("failed to parse non matching clause %v", required)
+	}
+	if !explain.Details[1].Match {
+		t.Errorf("failed to parse matching clause %v", explain.Details[1])
+	}
+}
+
+func TestParseExplanationInvalid(t *testing.T) {
+	if _, err := ParseExplanation("1.0 = a\nnot an explanation"); err == nil {
+		t.Error("failed to reject an invalid explanation")
+	}
+}
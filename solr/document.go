@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 type Parameters struct {
@@ -21,6 +22,50 @@ type Parameters struct {
 	LiteralId    string      `url:"literal.id,omitempty"`
 }
 
+type SelectParameters struct {
+	WT WT `url:"wt,omitempty"`
+
+	// The main query of the request, defaults to *:* when empty.
+	Query string `url:"q,omitempty"`
+
+	// Filter queries restricting the superset of documents that can be returned, without
+	// influencing score. Each filter query is cached independently.
+	FilterQuery []string `url:"fq,omitempty"`
+
+	// Limits the information included in a query response to a specified list of fields
+	// and pseudo-fields, e.g. id,score,[explain].
+	Fields string `url:"fl,omitempty"`
+
+	// Arranges search results in either ascending (asc) or descending (desc) order, e.g. score desc.
+	Sort string `url:"sort,omitempty"`
+
+	// The offset into the query result set from which Solr should begin displaying content.
+	Start int `url:"start,omitempty"`
+
+	// The maximum number of documents from the complete result set that Solr should return.
+	Rows int `url:"rows,omitempty"`
+
+	// Selects the query parser to be used to process the query, e.g. lucene, dismax or edismax.
+	DefType string `url:"defType,omitempty"`
+
+	// Query fields for the dismax and edismax query parsers.
+	QueryFields string `url:"qf,omitempty"`
+
+	// A rank query reranking the top documents of the main query, e.g. a {!ltr} query.
+	RankQuery string `url:"rq,omitempty"`
+
+	// The parameter sets of the Request Parameters API applied to the request, e.g. myQueries,myFacets.
+	UseParams []string `url:"useParams,comma,omitempty"`
+
+	MoreLikeThisComponent
+	GroupParameters
+	ExpandParameters
+	StatsParameters
+	SpatialParameters
+	DebugParameters
+	ElevationParameters
+}
+
 type Delete struct {
 	Id    string `json:"id,omitempty"`
 	Query string `json:"query,omitempty"`
@@ -36,9 +81,28 @@ type DocumentAPI struct {
 func (d *DocumentAPI) Select(ctx context.Context, collection string, query string) (*Response, error) {
 	path := fmt.Sprintf("/solr/%s/select", collection)
 
-	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil, &Parameters{
+	req, err := d.newSearchRequest(ctx, path, &Parameters{
 		Query: query,
-	}, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := d.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// SEARCH: Select documents using the full set of search parameters
+func (d *DocumentAPI) Search(ctx context.Context, collection string, params SelectParameters) (*Response, error) {
+	params.WT = JSON
+
+	path := fmt.Sprintf("/solr/%s/select", collection)
+
+	req, err := d.newSearchRequest(ctx, path, params)
 	if err != nil {
 		return nil, err
 	}
@@ -51,6 +115,45 @@ func (d *DocumentAPI) Select(ctx context.Context, collection string, query strin
 	return response, err
 }
 
+// QUERY: Search documents using the JSON Request API
+func (d *DocumentAPI) Query(ctx context.Context, collection string, query JSONQuery) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/query", collection)
+
+	req, err := d.client.NewRequest(ctx, http.MethodPost, path, query, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := d.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// newSearchRequest builds a GET search request, falling back to a POST with the parameters
+// form encoded in the body when the URL is longer than the client max URL length.
+func (d *DocumentAPI) newSearchRequest(ctx context.Context, path string, params interface{}) (*http.Request, error) {
+	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	maxURLLength := d.client.maxURLLength
+	if maxURLLength <= 0 {
+		maxURLLength = DefaultMaxURLLength
+	}
+
+	if len(req.URL.String()) <= maxURLLength {
+		return req, nil
+	}
+
+	return d.client.NewRequest(ctx, http.MethodPost, path, strings.NewReader(req.URL.RawQuery), nil, &map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+	})
+}
+
 // UPDATE: Update/Insert document
 func (d *DocumentAPI) Update(ctx context.Context, collection string, doc Document, params *Parameters) (*Response, error) {
 
@@ -17,7 +17,6 @@ func TestDocumentCreate(t *testing.T) {
 		NumShards:            1,
 		ReplicationFactor:    1,
 		CollectionConfigName: "_default",
-		Async:                false,
 	})
 	if err != nil {
 		t.Errorf("failed to create collection %v", err)
@@ -202,8 +201,7 @@ func TestDocumentDeleteAll(t *testing.T) {
 	}
 
 	response, err = client.Collection.Delete(context.Background(), CollectionDelete{
-		Name:  "tests",
-		Async: false,
+		Name: "tests",
 	})
 	if err != nil {
 		t.Errorf("failed to delete collection %v", err)
@@ -223,7 +221,6 @@ func TestAtomicDocumentCreate(t *testing.T) {
 		NumShards:            1,
 		ReplicationFactor:    1,
 		CollectionConfigName: "_default",
-		Async:                false,
 	})
 	if err != nil {
 		t.Errorf("failed to create collection %v", err)
@@ -332,8 +329,7 @@ func TestAtomicDocumentDropCollection(t *testing.T) {
 	client := NewClient()
 
 	response, err := client.Collection.Delete(context.Background(), CollectionDelete{
-		Name:  "atomic-tests",
-		Async: false,
+		Name: "atomic-tests",
 	})
 	if err != nil {
 		t.Errorf("failed to delete collection %v", err)
@@ -0,0 +1,72 @@
+// https://lucene.apache.org/solr/guide/8_5/the-query-elevation-component.html
+// https://lucene.apache.org/solr/guide/8_5/query-re-ranking.html
+package solr
+
+import (
+	"strconv"
+)
+
+const (
+	// Document transformer flagging the documents elevated by the QueryElevationComponent.
+	ElevatedTransformer = "[elevated]"
+
+	// Document transformer flagging the excluded documents when MarkExcludes is set.
+	ExcludedTransformer = "[excluded]"
+)
+
+type ElevationParameters struct {
+	// For debugging it may be useful to see results with and without the elevated docs.
+	// To hide results, use enableElevation=false. The default is true.
+	EnableElevation *bool `url:"enableElevation,omitempty"`
+
+	// By default, this component respects the requested sort parameter: if the request asks
+	// to sort by date, it will order the results by date. If forceElevation=true, results will
+	// first return the boosted docs, then order by date.
+	ForceElevation bool `url:"forceElevation,omitempty"`
+
+	// If true, only the elevated documents are returned and all other documents are excluded.
+	Exclusive bool `url:"exclusive,omitempty"`
+
+	// Documents to elevate for this request, by unique key, in addition to the ones configured
+	// in elevate.xml.
+	ElevateIds []string `url:"elevateIds,comma,omitempty"`
+
+	// Documents to exclude for this request, by unique key, in addition to the ones configured
+	// in elevate.xml.
+	ExcludeIds []string `url:"excludeIds,comma,omitempty"`
+
+	// If true, the excluded documents are kept in the results and flagged by the [excluded]
+	// transformer instead of being removed.
+	MarkExcludes bool `url:"markExcludes,omitempty"`
+
+	// If true, the elevated documents are returned in the order they are configured, set to false
+	// to sort them like the other documents. The default is true.
+	UseConfiguredElevatedOrder *bool `url:"useConfiguredElevatedOrder,omitempty"`
+}
+
+type ReRankQuery struct {
+	// The query string for your complex ranking query, e.g. greetings:(hello OR hi), or a
+	// reference to another request parameter such as $rqq. This parameter is required.
+	ReRankQuery string
+
+	// The number of top N documents from the original query that should be re-ranked. The default is 200.
+	ReRankDocs int
+
+	// A multiplicative factor that will be applied to the score from the reRankQuery for each
+	// of the top matching documents, before that score is added to the original score. The default is 2.0.
+	ReRankWeight float64
+}
+
+// Query builds the {!rerank} query, to be sent as the rq parameter.
+func (r ReRankQuery) Query() string {
+	weight := ""
+	if r.ReRankWeight != 0 {
+		weight = strconv.FormatFloat(r.ReRankWeight, 'f', -1, 64)
+	}
+
+	return localParams("rerank",
+		"reRankQuery", r.ReRankQuery,
+		"reRankDocs", formatInt(r.ReRankDocs),
+		"reRankWeight", weight,
+	)
+}
@@ -0,0 +1,53 @@
+package solr
+
+import (
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestElevationParameters(t *testing.T) {
+	params, err := query.Values(SelectParameters{
+		Query:  "ipod",
+		Fields: "id,score," + ElevatedTransformer,
+		ElevationParameters: ElevationParameters{
+			ForceElevation: true,
+			ElevateIds:     []string{"doc1", "doc2"},
+			ExcludeIds:     []string{"doc3"},
+
+			UseConfiguredElevatedOrder: Bool(false),
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to encode parameters %v", err)
+	}
+
+	expected := "elevateIds=doc1%2Cdoc2&excludeIds=doc3&fl=id%2Cscore%2C%5Belevated%5D&forceElevation=true&q=ipod" +
+		"&useConfiguredElevatedOrder=false"
+	if params.Encode() != expected {
+		t.Errorf("failed to encode parameters %v", params.Encode())
+	}
+}
+
+func TestReRankQuery(t *testing.T) {
+	q := ReRankQuery{ReRankQuery: "$rqq", ReRankDocs: 1000, ReRankWeight: 3}.Query()
+	if q != "{!rerank reRankQuery=$rqq reRankDocs=1000 reRankWeight=3}" {
+		t.Errorf("failed to build rerank query %v", q)
+	}
+
+	q = ReRankQuery{ReRankQuery: "greetings:(hello OR hi)"}.Query()
+	if q != "{!rerank reRankQuery='greetings:(hello OR hi)'}" {
+		t.Errorf("failed to build rerank query %v", q)
+	}
+}
+
+func TestElevationDisabled(t *testing.T) {
+	params, err := query.Values(ElevationParameters{EnableElevation: Bool(false)})
+	if err != nil {
+		t.Fatalf("failed to encode parameters %v", err)
+	}
+
+	if params.Encode() != "enableElevation=false" {
+		t.Errorf("failed to disable elevation %v", params.Encode())
+	}
+}
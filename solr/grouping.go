@@ -0,0 +1,132 @@
+// https://lucene.apache.org/solr/guide/8_5/result-grouping.html
+// https://lucene.apache.org/solr/guide/8_5/collapse-and-expand-results.html
+package solr
+
+type GroupFormat string
+
+const (
+	GroupFormatGrouped GroupFormat = "grouped"
+	GroupFormatSimple  GroupFormat = "simple"
+)
+
+type CollapseNullPolicy string
+
+const (
+	CollapseNullIgnore   CollapseNullPolicy = "ignore"
+	CollapseNullExpand   CollapseNullPolicy = "expand"
+	CollapseNullCollapse CollapseNullPolicy = "collapse"
+)
+
+type GroupParameters struct {
+	// If true, query results will be grouped.
+	Enable bool `url:"group,omitempty"`
+
+	// The name of the field by which to group results. The field must be single-valued, and either
+	// be indexed or a field type that has a value source and works in a function query. The
+	// parameter can be given multiple times, each field being returned as a separate group.
+	Field []string `url:"group.field,omitempty"`
+
+	// Return a single group of documents that match the given query. The parameter can be given
+	// multiple times.
+	Query []string `url:"group.query,omitempty"`
+
+	// Group based on the unique values of a function query. The parameter can be given
+	// multiple times.
+	Func []string `url:"group.func,omitempty"`
+
+	// The number of results (documents) to return for each group, 0 only returns the number of
+	// documents of each group. The default value is 1.
+	Limit *int `url:"group.limit,omitempty"`
+
+	// The initial offset for the document list of each group.
+	Offset int `url:"group.offset,omitempty"`
+
+	// How to sort documents within a single group. The default value is score desc.
+	Sort string `url:"group.sort,omitempty"`
+
+	// If simple, the grouped documents are presented in a single flat list, and the start and
+	// rows parameters affect the numbers of documents instead of groups. Defaults to grouped.
+	Format GroupFormat `url:"group.format,omitempty"`
+
+	// If true, the result of the first field grouping command is used as the main result
+	// list in the response, using group.format=simple.
+	Main bool `url:"group.main,omitempty"`
+
+	// If true, Solr includes the number of groups that have matched the query in the results.
+	NGroups bool `url:"group.ngroups,omitempty"`
+
+	// If true, facet counts are based on the most relevant document of each group matching the query.
+	Truncate bool `url:"group.truncate,omitempty"`
+
+	// Determines whether to compute grouped facets for the field facets specified in facet.field parameters.
+	Facet bool `url:"group.facet,omitempty"`
+
+	// Setting this parameter to a number greater than 0 enables caching for result grouping.
+	CachePercent int `url:"group.cache.percent,omitempty"`
+}
+
+type ExpandParameters struct {
+	// When true, the group heads returned by the collapse query parser are expanded.
+	Enable bool `url:"expand,omitempty"`
+
+	// Orders the documents within the expanded groups. The default is score desc.
+	Sort string `url:"expand.sort,omitempty"`
+
+	// The number of rows to display in each group. The default is 5 rows.
+	Rows int `url:"expand.rows,omitempty"`
+
+	// Overrides the main query (q), determines which documents to include in the main group.
+	Query string `url:"expand.q,omitempty"`
+
+	// Overrides main filter queries (fq), determines which documents to include in the main group.
+	FilterQuery []string `url:"expand.fq,omitempty"`
+}
+
+type CollapseQuery struct {
+	// The field that is being collapsed on. The field must be a single valued String, Int or Float-type of field.
+	Field string
+
+	// Selects the group head with the minimum value of the given numeric field or function query.
+	Min string
+
+	// Selects the group head with the maximum value of the given numeric field or function query.
+	Max string
+
+	// Selects the group head using the sort clause, e.g. "price asc, score desc".
+	Sort string
+
+	// Determines how documents with a null value in the collapse field are treated.
+	NullPolicy CollapseNullPolicy
+
+	// Currently there is only one hint available: top_fc, which stands for top level FieldCache.
+	Hint string
+
+	// Sets the initial size of the collapse data structures when collapsing on a numeric field only.
+	Size int
+}
+
+type GroupResult struct {
+	Matches int     `json:"matches"`
+	NGroups int     `json:"ngroups,omitempty"`
+	Groups  []Group `json:"groups,omitempty"`
+	DocList Result  `json:"doclist,omitempty"`
+}
+
+type Group struct {
+	GroupValue interface{} `json:"groupValue"`
+	DocList    Result      `json:"doclist,omitempty"`
+}
+
+// Query builds the {!collapse} filter query, used together with ExpandParameters
+// to return the collapsed documents of each group head.
+func (c CollapseQuery) Query() string {
+	return localParams("collapse",
+		"field", c.Field,
+		"min", c.Min,
+		"max", c.Max,
+		"sort", c.Sort,
+		"nullPolicy", string(c.NullPolicy),
+		"hint", c.Hint,
+		"size", formatInt(c.Size),
+	)
+}
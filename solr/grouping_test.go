@@ -0,0 +1,95 @@
+package solr
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestGroupedResponseDecode(t *testing.T) {
+	b := []byte(`{
+		"responseHeader": {"status": 0, "QTime": 3},
+		"grouped": {
+			"manu_exact": {
+				"matches": 4,
+				"ngroups": 2,
+				"groups": [
+					{"groupValue": "Apache", "doclist": {"numFound": 3, "start": 0, "docs": [{"id": "1"}]}},
+					{"groupValue": null, "doclist": {"numFound": 1, "start": 0, "docs": [{"id": "4"}]}}
+				]
+			},
+			"popularity:[10 TO *]": {
+				"matches": 4,
+				"doclist": {"numFound": 2, "start": 0, "docs": [{"id": "2"}, {"id": "3"}]}
+			}
+		},
+		"expanded": {
+			"Apache": {"numFound": 2, "start": 0, "docs": [{"id": "2"}, {"id": "3"}]}
+		}
+	}`)
+
+	var response Response
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatalf("failed to decode grouped response %v", err)
+	}
+
+	field := response.Grouped["manu_exact"]
+	if field.Matches != 4 || field.NGroups != 2 || len(field.Groups) != 2 {
+		t.Fatalf("failed to decode field group %v", field)
+	}
+
+	if field.Groups[0].GroupValue != "Apache" || field.Groups[0].DocList.NumFound != 3 || field.Groups[1].GroupValue != nil {
+		t.Errorf("failed to decode groups %v", field.Groups)
+	}
+
+	if response.Grouped["popularity:[10 TO *]"].DocList.NumFound != 2 {
+		t.Errorf("failed to decode query group %v", response.Grouped["popularity:[10 TO *]"])
+	}
+
+	if response.Expanded["Apache"].NumFound != 2 {
+		t.Errorf("failed to decode expanded %v", response.Expanded)
+	}
+}
+
+func TestGroupParameters(t *testing.T) {
+	params, err := query.Values(SelectParameters{
+		Query: "*:*",
+		GroupParameters: GroupParameters{
+			Enable:  true,
+			Field:   []string{"manu_exact"},
+			Query:   []string{"popularity:[10 TO *]"},
+			Limit:   Int(0),
+			NGroups: true,
+			Format:  GroupFormatGrouped,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to encode parameters %v", err)
+	}
+
+	for key, value := range map[string]string{
+		"group":         "true",
+		"group.field":   "manu_exact",
+		"group.query":   "popularity:[10 TO *]",
+		"group.limit":   "0",
+		"group.ngroups": "true",
+		"group.format":  "grouped",
+	} {
+		if params.Get(key) != value {
+			t.Errorf("failed to encode %v parameter %v", key, params.Get(key))
+		}
+	}
+}
+
+func TestCollapseQuery(t *testing.T) {
+	q := CollapseQuery{
+		Field:      "group_s",
+		Sort:       "price asc, score desc",
+		NullPolicy: CollapseNullExpand,
+	}.Query()
+
+	if q != "{!collapse field=group_s sort='price asc, score desc' nullPolicy=expand}" {
+		t.Errorf("failed to build collapse query %v", q)
+	}
+}
@@ -0,0 +1,143 @@
+// https://lucene.apache.org/solr/guide/8_5/json-request-api.html
+// https://lucene.apache.org/solr/guide/8_5/json-query-dsl.html
+package solr
+
+import (
+	"encoding/json"
+)
+
+type JSONQuery struct {
+	// The main query, either a query string or a JSON Query DSL query such as BoolQuery,
+	// LuceneQuery, EDisMaxQuery or FRangeQuery.
+	Query interface{} `json:"query,omitempty"`
+
+	// Filter queries, each one either a query string or a JSON Query DSL query.
+	Filter []interface{} `json:"filter,omitempty"`
+
+	// Fields and pseudo-fields to return.
+	Fields []string `json:"fields,omitempty"`
+
+	// Sort clause, e.g. score desc, id asc.
+	Sort string `json:"sort,omitempty"`
+
+	// The offset into the query result set.
+	Offset int `json:"offset,omitempty"`
+
+	// The maximum number of documents to return, 0 only returns the facets and stats. The
+	// default is 10.
+	Limit *int `json:"limit,omitempty"`
+
+	// Any other request parameter, e.g. defType or debug.
+	Params map[string]interface{} `json:"params,omitempty"`
+
+	// JSON Facet API facets, results are available in Response.Facets.
+	Facet map[string]interface{} `json:"facet,omitempty"`
+
+	// Additional queries that can be referenced by name from the query, filter or facet
+	// sections using the {"param": "name"} syntax.
+	Queries map[string]interface{} `json:"queries,omitempty"`
+}
+
+type BoolQuery struct {
+	// Clauses that must match and contribute to the score.
+	Must []interface{} `json:"must,omitempty"`
+
+	// Clauses that must not match.
+	MustNot []interface{} `json:"must_not,omitempty"`
+
+	// Optional clauses contributing to the score.
+	Should []interface{} `json:"should,omitempty"`
+
+	// Clauses that must match without contributing to the score.
+	Filter []interface{} `json:"filter,omitempty"`
+
+	// Minimum number of should clauses that must match.
+	MM string `json:"mm,omitempty"`
+}
+
+type LuceneQuery struct {
+	Query string `json:"query,omitempty"`
+
+	// Default field used when a term has no explicit field.
+	DF string `json:"df,omitempty"`
+
+	// Default operator, either AND or OR.
+	QOp string `json:"q.op,omitempty"`
+}
+
+type EDisMaxQuery struct {
+	Query string `json:"query,omitempty"`
+
+	// Query fields and their boosts, e.g. title^2 body.
+	QF string `json:"qf,omitempty"`
+
+	// Minimum should match.
+	MM string `json:"mm,omitempty"`
+
+	// Phrase fields used to boost documents matching all the terms in close proximity.
+	PF string `json:"pf,omitempty"`
+
+	// Additive boost queries.
+	BQ []string `json:"bq,omitempty"`
+
+	// Multiplicative boost functions.
+	Boost string `json:"boost,omitempty"`
+
+	// Tie breaker used to combine the scores of the query fields.
+	Tie float64 `json:"tie,omitempty"`
+}
+
+type FRangeQuery struct {
+	// The function query whose value is matched against the range.
+	Query string `json:"query,omitempty"`
+
+	// Lower bound of the range, unbounded when nil.
+	Lower interface{} `json:"l,omitempty"`
+
+	// Upper bound of the range, unbounded when nil.
+	Upper interface{} `json:"u,omitempty"`
+
+	// Excludes the lower bound, which is included by default.
+	ExcludeLower bool `json:"-"`
+
+	// Excludes the upper bound, which is included by default.
+	ExcludeUpper bool `json:"-"`
+}
+
+func (q BoolQuery) MarshalJSON() ([]byte, error) {
+	type boolQuery BoolQuery
+
+	return json.Marshal(map[string]interface{}{"bool": boolQuery(q)})
+}
+
+func (q LuceneQuery) MarshalJSON() ([]byte, error) {
+	type luceneQuery LuceneQuery
+
+	return json.Marshal(map[string]interface{}{"lucene": luceneQuery(q)})
+}
+
+func (q EDisMaxQuery) MarshalJSON() ([]byte, error) {
+	type edismaxQuery EDisMaxQuery
+
+	return json.Marshal(map[string]interface{}{"edismax": edismaxQuery(q)})
+}
+
+func (q FRangeQuery) MarshalJSON() ([]byte, error) {
+	frange := map[string]interface{}{
+		"query": q.Query,
+	}
+	if q.Lower != nil {
+		frange["l"] = q.Lower
+	}
+	if q.Upper != nil {
+		frange["u"] = q.Upper
+	}
+	if q.ExcludeLower {
+		frange["incl"] = false
+	}
+	if q.ExcludeUpper {
+		frange["incu"] = false
+	}
+
+	return json.Marshal(map[string]interface{}{"frange": frange})
+}
@@ -0,0 +1,68 @@
+package solr
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestJSONQueryEncode(t *testing.T) {
+	b, err := json.Marshal(JSONQuery{
+		Query: BoolQuery{
+			Must: []interface{}{
+				LuceneQuery{Query: "title:solr", DF: "body"},
+				EDisMaxQuery{Query: "search engine", QF: "title^2 body"},
+			},
+			Filter: []interface{}{FRangeQuery{Query: "price", Lower: 0, Upper: 100, ExcludeUpper: true}},
+		},
+		Filter: []interface{}{"inStock:true"},
+		Fields: []string{"id", "score"},
+		Limit:  Int(0),
+		Facet: map[string]interface{}{
+			"categories": map[string]interface{}{"type": "terms", "field": "cat"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to encode json query %v", err)
+	}
+
+	expected := `{"query":{"bool":{"must":[{"lucene":{"query":"title:solr","df":"body"}},{"edismax":{"query":"search engine","qf":"title^2 body"}}],` +
+		`"filter":[{"frange":{"incu":false,"l":0,"query":"price","u":100}}]}},"filter":["inStock:true"],"fields":["id","score"],"limit":0,` +
+		`"facet":{"categories":{"field":"cat","type":"terms"}}}`
+	if string(b) != expected {
+		t.Errorf("failed to encode json query %v", string(b))
+	}
+}
+
+func TestSearchFallbackToPost(t *testing.T) {
+	var method, body string
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		method, body = r.Method, string(b)
+		_, _ = w.Write([]byte(`{"responseHeader": {"status": 0}, "response": {"numFound": 0, "start": 0, "docs": []}}`))
+	})
+	defer server.Close()
+
+	client.SetMaxURLLength(256)
+
+	_, err := client.Document.Search(context.Background(), "tests", SelectParameters{Query: "id:1"})
+	if err != nil {
+		t.Fatalf("failed to search %v", err)
+	}
+
+	if method != http.MethodGet {
+		t.Errorf("failed to search with GET for a short url %v", method)
+	}
+
+	_, err = client.Document.Search(context.Background(), "tests", SelectParameters{Query: strings.Repeat("id:1 OR ", 64) + "id:2"})
+	if err != nil {
+		t.Fatalf("failed to search %v", err)
+	}
+
+	if method != http.MethodPost || !strings.Contains(body, "q=id%3A1+OR") {
+		t.Errorf("failed to search with POST for a long url %v %v", method, body)
+	}
+}
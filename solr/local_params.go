@@ -0,0 +1,52 @@
+package solr
+
+import (
+	"strconv"
+	"strings"
+)
+
+// localParams formats a local params query prefix such as {!parser key=value}, keyValues
+// are given as key/value pairs. Pairs with an empty value are skipped and values holding
+// whitespace or special characters are quoted. The parser may be left empty.
+func localParams(parser string, keyValues ...string) string {
+	var params []string
+
+	if parser != "" {
+		params = append(params, parser)
+	}
+	for i := 0; i+1 < len(keyValues); i += 2 {
+		if keyValues[i+1] == "" {
+			continue
+		}
+		params = append(params, keyValues[i]+"="+quoteLocalParam(keyValues[i+1]))
+	}
+
+	return "{!" + strings.Join(params, " ") + "}"
+}
+
+// quoteLocalParam quotes a local param value when it can't be used bare.
+func quoteLocalParam(value string) string {
+	if !strings.ContainsAny(value, " \t\n'\"{}\\") {
+		return value
+	}
+
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+// formatInt formats a local param integer, zero values are left empty.
+func formatInt(i int) string {
+	if i == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(i)
+}
+
+// formatBool formats a local param boolean, false values are left empty.
+func formatBool(b bool) string {
+	if !b {
+		return ""
+	}
+
+	return "true"
+}
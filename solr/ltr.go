@@ -0,0 +1,303 @@
+// https://lucene.apache.org/solr/guide/8_5/learning-to-rank.html
+package solr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	SolrFeatureClass           = "org.apache.solr.ltr.feature.SolrFeature"
+	ValueFeatureClass          = "org.apache.solr.ltr.feature.ValueFeature"
+	FieldValueFeatureClass     = "org.apache.solr.ltr.feature.FieldValueFeature"
+	FieldLengthFeatureClass    = "org.apache.solr.ltr.feature.FieldLengthFeature"
+	OriginalScoreFeatureClass  = "org.apache.solr.ltr.feature.OriginalScoreFeature"
+	LinearModelClass           = "org.apache.solr.ltr.model.LinearModel"
+	MultipleAdditiveTreesClass = "org.apache.solr.ltr.model.MultipleAdditiveTreesModel"
+	MinMaxNormalizerClass      = "org.apache.solr.ltr.norm.MinMaxNormalizer"
+	StandardNormalizerClass    = "org.apache.solr.ltr.norm.StandardNormalizer"
+)
+
+type LTRAPI struct {
+	client *Client
+}
+
+type Feature struct {
+	// The name of the feature, referenced by the models.
+	Name string `json:"name"`
+
+	// The feature class, e.g. SolrFeatureClass or FieldValueFeatureClass.
+	Class string `json:"class"`
+
+	// The feature parameters, depending on the class, e.g. {"q": "{!func}recip(ms(NOW,last_modified),3.16e-11,1,1)"}
+	// for a SolrFeature or {"field": "popularity"} for a FieldValueFeature.
+	Params map[string]interface{} `json:"params,omitempty"`
+
+	// The feature store holding the feature, _DEFAULT_ when empty.
+	Store string `json:"store,omitempty"`
+}
+
+type Model struct {
+	// The name of the model, used by the {!ltr} query parser.
+	Name string `json:"name"`
+
+	// The model class, e.g. LinearModelClass or MultipleAdditiveTreesClass.
+	Class string `json:"class"`
+
+	// The feature store holding the features of the model, _DEFAULT_ when empty.
+	Store string `json:"store,omitempty"`
+
+	// The features used by the model and their normalizers.
+	Features []ModelFeature `json:"features"`
+
+	// The model parameters, LinearModelParams or MultipleAdditiveTreesParams when uploading
+	// models. Use DecodeParams to read the parameters of models returned by Solr.
+	Params interface{} `json:"params,omitempty"`
+}
+
+type ModelFeature struct {
+	Name string      `json:"name"`
+	Norm *Normalizer `json:"norm,omitempty"`
+}
+
+type Normalizer struct {
+	// The normalizer class, e.g. MinMaxNormalizerClass or StandardNormalizerClass.
+	Class string `json:"class"`
+
+	// The normalizer parameters, e.g. min and max or avg and std.
+	Params map[string]string `json:"params,omitempty"`
+}
+
+type LinearModelParams struct {
+	// The weight of each feature, by feature name.
+	Weights map[string]float64 `json:"weights"`
+}
+
+type MultipleAdditiveTreesParams struct {
+	Trees []RegressionTree `json:"trees"`
+}
+
+type RegressionTree struct {
+	Weight LTRNumber `json:"weight"`
+	Root   TreeNode  `json:"root"`
+}
+
+// A node of a regression tree, either a split on a feature threshold or a leaf holding a value.
+type TreeNode struct {
+	Feature   string    `json:"feature,omitempty"`
+	Threshold LTRNumber `json:"threshold,omitempty"`
+	Left      *TreeNode `json:"left,omitempty"`
+	Right     *TreeNode `json:"right,omitempty"`
+	Value     LTRNumber `json:"value,omitempty"`
+}
+
+// A number of a model definition, Solr accepts both JSON numbers and strings such as "0.5f".
+type LTRNumber string
+
+type LTRQuery struct {
+	// The name of the model used to rerank the documents. This parameter is required.
+	Model string
+
+	// The number of top documents to rerank, the default is 10.
+	ReRankDocs int
+
+	// External feature information, passed to the features as efi.<name>=<value>.
+	EFI map[string]string
+}
+
+type FeaturesTransformer struct {
+	// The feature store whose features are extracted, defaults to the store of the model
+	// used to rerank the documents.
+	Store string
+
+	// External feature information, passed to the features as efi.<name>=<value>.
+	EFI map[string]string
+
+	// The output format of the feature vector, dense or sparse.
+	Format string
+}
+
+func (n *LTRNumber) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*n = LTRNumber(s)
+		return nil
+	}
+
+	var f float64
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+	*n = LTRNumber(strconv.FormatFloat(f, 'f', -1, 64))
+
+	return nil
+}
+
+// DecodeParams decodes the model parameters into v, e.g. a LinearModelParams.
+func (m Model) DecodeParams(v interface{}) error {
+	b, err := json.Marshal(m.Params)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+// Query builds the {!ltr} rerank query, e.g. {!ltr model=myModel reRankDocs=100 efi.text=foo},
+// to be sent as the rq parameter.
+func (q LTRQuery) Query() string {
+	keyValues := []string{"model", q.Model, "reRankDocs", formatInt(q.ReRankDocs)}
+
+	return localParams("ltr", append(keyValues, efiParams(q.EFI)...)...)
+}
+
+// String builds the [features] document transformer, added to the fl parameter to return
+// the feature values of each document.
+func (f FeaturesTransformer) String() string {
+	keyValues := append([]string{"store", f.Store}, efiParams(f.EFI)...)
+	keyValues = append(keyValues, "format", f.Format)
+
+	params := localParams("features", keyValues...)
+
+	return "[" + strings.TrimSuffix(strings.TrimPrefix(params, "{!"), "}") + "]"
+}
+
+// efiParams returns the external feature information as key/value pairs sorted by name.
+func efiParams(efi map[string]string) []string {
+	names := make([]string, 0, len(efi))
+	for name := range efi {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	keyValues := make([]string, 0, len(efi)*2)
+	for _, name := range names {
+		keyValues = append(keyValues, "efi."+name, efi[name])
+	}
+
+	return keyValues
+}
+
+// FEATURE STORES: List the feature stores of a collection
+func (l *LTRAPI) FeatureStores(ctx context.Context, collection string) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/schema/feature-store", collection)
+
+	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := l.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// FEATURES: List the features of a feature store
+func (l *LTRAPI) Features(ctx context.Context, collection string, store string) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/schema/feature-store/%s", collection, store)
+
+	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := l.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// UPLOAD FEATURES: Upload features to their feature stores
+func (l *LTRAPI) UploadFeatures(ctx context.Context, collection string, features []Feature) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/schema/feature-store", collection)
+
+	req, err := l.client.NewRequest(ctx, http.MethodPut, path, features, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := l.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// DELETE FEATURE STORE: Delete a feature store and all of its features
+func (l *LTRAPI) DeleteFeatureStore(ctx context.Context, collection string, store string) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/schema/feature-store/%s", collection, store)
+
+	req, err := l.client.NewRequest(ctx, http.MethodDelete, path, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := l.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// MODELS: List the models of a collection
+func (l *LTRAPI) Models(ctx context.Context, collection string) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/schema/model-store", collection)
+
+	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := l.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// UPLOAD MODEL: Upload a model, its features must already be in the feature store
+func (l *LTRAPI) UploadModel(ctx context.Context, collection string, model Model) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/schema/model-store", collection)
+
+	req, err := l.client.NewRequest(ctx, http.MethodPut, path, model, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := l.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// DELETE MODEL: Delete a model
+func (l *LTRAPI) DeleteModel(ctx context.Context, collection string, name string) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/schema/model-store/%s", collection, name)
+
+	req, err := l.client.NewRequest(ctx, http.MethodDelete, path, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := l.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
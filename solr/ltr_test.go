@@ -0,0 +1,84 @@
+package solr
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLTRQuery(t *testing.T) {
+	q := LTRQuery{
+		Model:      "myModel",
+		ReRankDocs: 100,
+		EFI:        map[string]string{"text": "running shoes", "user": "42"},
+	}.Query()
+
+	if q != "{!ltr model=myModel reRankDocs=100 efi.text='running shoes' efi.user=42}" {
+		t.Errorf("failed to build ltr query %v", q)
+	}
+
+	transformer := FeaturesTransformer{Store: "myStore", EFI: map[string]string{"text": "shoes"}}.String()
+	if transformer != "[features store=myStore efi.text=shoes]" {
+		t.Errorf("failed to build features transformer %v", transformer)
+	}
+}
+
+func TestModelEncode(t *testing.T) {
+	b, err := json.Marshal(Model{
+		Name:  "myModel",
+		Class: LinearModelClass,
+		Store: "myStore",
+		Features: []ModelFeature{
+			{Name: "originalScore"},
+			{Name: "popularity", Norm: &Normalizer{Class: MinMaxNormalizerClass, Params: map[string]string{"min": "0", "max": "10"}}},
+		},
+		Params: LinearModelParams{Weights: map[string]float64{"originalScore": 1, "popularity": 0.5}},
+	})
+	if err != nil {
+		t.Fatalf("failed to encode model %v", err)
+	}
+
+	expected := `{"name":"myModel","class":"org.apache.solr.ltr.model.LinearModel","store":"myStore","features":[{"name":"originalScore"},` +
+		`{"name":"popularity","norm":{"class":"org.apache.solr.ltr.norm.MinMaxNormalizer","params":{"max":"10","min":"0"}}}],` +
+		`"params":{"weights":{"originalScore":1,"popularity":0.5}}}`
+	if string(b) != expected {
+		t.Errorf("failed to encode model %v", string(b))
+	}
+}
+
+func TestModelStoreResponseDecode(t *testing.T) {
+	b := []byte(`{
+		"responseHeader": {"status": 0, "QTime": 0},
+		"models": [{
+			"name": "treesModel",
+			"class": "org.apache.solr.ltr.model.MultipleAdditiveTreesModel",
+			"store": "_DEFAULT_",
+			"features": [{"name": "userTextTitleMatch", "norm": {"class": "org.apache.solr.ltr.norm.IdentityNormalizer"}}],
+			"params": {
+				"trees": [{
+					"weight": "1f",
+					"root": {
+						"feature": "userTextTitleMatch",
+						"threshold": 0.5,
+						"left": {"value": "-100"},
+						"right": {"value": 50}
+					}
+				}]
+			}
+		}]
+	}`)
+
+	var response Response
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatalf("failed to decode model store response %v", err)
+	}
+
+	var params MultipleAdditiveTreesParams
+	if err := response.Models[0].DecodeParams(&params); err != nil {
+		t.Fatalf("failed to decode model params %v", err)
+	}
+
+	root := params.Trees[0].Root
+	if params.Trees[0].Weight != "1f" || root.Threshold != "0.5" || root.Left.Value != "-100" || root.Right.Value != "50" {
+		t.Errorf("failed to decode trees %v", params.Trees)
+	}
+}
@@ -0,0 +1,234 @@
+// https://lucene.apache.org/solr/guide/8_5/morelikethis.html
+package solr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+type InterestingTermsMode string
+
+const (
+	InterestingTermsList    InterestingTermsMode = "list"
+	InterestingTermsDetails InterestingTermsMode = "details"
+	InterestingTermsNone    InterestingTermsMode = "none"
+)
+
+type MoreLikeThisParameters struct {
+	// Specifies the fields to use for similarity. If possible, these should have stored termVectors.
+	Fields []string `url:"mlt.fl,comma,omitempty"`
+
+	// Specifies the Minimum Term Frequency, the frequency below which terms will be ignored in
+	// the source document.
+	MinTermFreq int `url:"mlt.mintf,omitempty"`
+
+	// Specifies the Minimum Document Frequency, the frequency at which words will be ignored which
+	// do not occur in at least this many documents.
+	MinDocFreq int `url:"mlt.mindf,omitempty"`
+
+	// Specifies the Maximum Document Frequency, the frequency at which words will be ignored which
+	// occur in more than this many documents.
+	MaxDocFreq int `url:"mlt.maxdf,omitempty"`
+
+	// Same as MaxDocFreq, but expressed as a percentage of the number of documents in the index.
+	MaxDocFreqPct int `url:"mlt.maxdfpct,omitempty"`
+
+	// Sets the minimum word length below which words will be ignored.
+	MinWordLen int `url:"mlt.minwl,omitempty"`
+
+	// Sets the maximum word length above which words will be ignored.
+	MaxWordLen int `url:"mlt.maxwl,omitempty"`
+
+	// Sets the maximum number of query terms that will be included in any generated query.
+	MaxQueryTerms int `url:"mlt.maxqt,omitempty"`
+
+	// Sets the maximum number of tokens to parse in each example document field that is not
+	// stored with TermVector support.
+	MaxNumTokensParsed int `url:"mlt.maxntp,omitempty"`
+
+	// Specifies if the query will be boosted by the interesting term relevance.
+	Boost bool `url:"mlt.boost,omitempty"`
+
+	// Query fields and their boosts using the same format as that used by the DisMax
+	// Query Parser. These fields must also be specified in Fields.
+	QueryFields string `url:"mlt.qf,omitempty"`
+
+	// Controls how the MoreLikeThis component presents the "interesting" terms (the top TF/IDF
+	// terms) for the query. Supports three settings: list, details and none.
+	InterestingTerms InterestingTermsMode `url:"mlt.interestingTerms,omitempty"`
+}
+
+type MoreLikeThisComponent struct {
+	// If set to true, activates the MoreLikeThis component and enables Solr to return
+	// "More Like This" results for each document of the main result.
+	Enable bool `url:"mlt,omitempty"`
+
+	// Specifies the number of similar documents to be returned for each result.
+	Count int `url:"mlt.count,omitempty"`
+
+	MoreLikeThisParameters
+}
+
+type MoreLikeThisRequest struct {
+	WT WT `url:"wt,omitempty"`
+
+	// The query used to find the document to match against, e.g. id:123. Ignored when
+	// posting an external text stream.
+	Query string `url:"q,omitempty"`
+
+	// Filter queries applied to the similar documents.
+	FilterQuery []string `url:"fq,omitempty"`
+
+	// Fields and pseudo-fields returned for the similar documents.
+	ReturnFields string `url:"fl,omitempty"`
+
+	// The offset into the similar documents result set.
+	Start int `url:"start,omitempty"`
+
+	// The maximum number of similar documents to return.
+	Rows int `url:"rows,omitempty"`
+
+	// Specifies whether or not the response should include the matched document.
+	MatchInclude bool `url:"mlt.match.include,omitempty"`
+
+	// Specifies an offset into the main query search results to locate the document on
+	// which the MoreLikeThis query should operate.
+	MatchOffset int `url:"mlt.match.offset,omitempty"`
+
+	MoreLikeThisParameters
+}
+
+type InterestingTerm struct {
+	Term  string
+	Boost float64
+}
+
+// Interesting terms as returned for mlt.interestingTerms, the boost is only
+// present when the details mode is used.
+type InterestingTerms []InterestingTerm
+
+func (t *InterestingTerms) UnmarshalJSON(b []byte) error {
+	// The details are a named list of term/boost pairs, kept in the ranked order of Solr.
+	var pairs []json.RawMessage
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")) {
+		pairs, err = orderedPairs(b)
+	} else {
+		err = json.Unmarshal(b, &pairs)
+	}
+	if err != nil {
+		return err
+	}
+
+	*t = make(InterestingTerms, 0, len(pairs))
+	for _, pair := range pairs {
+		var value interface{}
+		if err := json.Unmarshal(pair, &value); err != nil {
+			return err
+		}
+
+		switch v := value.(type) {
+		case string:
+			*t = append(*t, InterestingTerm{Term: v})
+		case float64:
+			if len(*t) == 0 {
+				return fmt.Errorf("solr: interesting term boost %v without term", v)
+			}
+			(*t)[len(*t)-1].Boost = v
+		}
+	}
+
+	return nil
+}
+
+type MoreLikeThisQuery struct {
+	// Fields to use for similarity.
+	QueryFields []string
+
+	// Minimum Term Frequency, the frequency below which terms will be ignored in the source document.
+	MinTermFreq int
+
+	// Minimum Document Frequency, words not occurring in at least this many documents are ignored.
+	MinDocFreq int
+
+	// Maximum Document Frequency, words occurring in more than this many documents are ignored.
+	MaxDocFreq int
+
+	// Minimum word length below which words will be ignored.
+	MinWordLen int
+
+	// Maximum word length above which words will be ignored.
+	MaxWordLen int
+
+	// Maximum number of query terms that will be included in any generated query.
+	MaxQueryTerms int
+
+	// Maximum number of tokens to parse in each example document field.
+	MaxNumTokensParsed int
+
+	// Specifies if the query will be boosted by the interesting term relevance.
+	Boost bool
+}
+
+// Query builds a query using the MoreLikeThis query parser, {!mlt qf=... mintf=...}id,
+// returning documents similar to the document with the given unique key.
+func (m MoreLikeThisQuery) Query(id string) string {
+	return localParams("mlt",
+		"qf", strings.Join(m.QueryFields, ","),
+		"mintf", formatInt(m.MinTermFreq),
+		"mindf", formatInt(m.MinDocFreq),
+		"maxdf", formatInt(m.MaxDocFreq),
+		"minwl", formatInt(m.MinWordLen),
+		"maxwl", formatInt(m.MaxWordLen),
+		"maxqt", formatInt(m.MaxQueryTerms),
+		"maxntp", formatInt(m.MaxNumTokensParsed),
+		"boost", formatBool(m.Boost),
+	) + id
+}
+
+// MORE LIKE THIS: Similar documents using the MoreLikeThis request handler
+// The matched document is available in Response.Match and the similar documents in Response.Response.
+func (d *DocumentAPI) MoreLikeThis(ctx context.Context, collection string, mlt MoreLikeThisRequest) (*Response, error) {
+	mlt.WT = JSON
+
+	path := fmt.Sprintf("/solr/%s/mlt", collection)
+
+	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil, mlt, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := d.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// MORE LIKE THIS STREAM: Similar documents to an external text stream
+// The text is posted as the content stream of the MoreLikeThis request handler.
+func (d *DocumentAPI) MoreLikeThisStream(ctx context.Context, collection string, stream io.Reader, mlt MoreLikeThisRequest) (*Response, error) {
+	mlt.WT = JSON
+
+	path := fmt.Sprintf("/solr/%s/mlt", collection)
+
+	req, err := d.client.NewRequest(ctx, http.MethodPost, path, stream, mlt, &map[string]string{
+		"Content-Type": "text/plain; charset=utf-8",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := d.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
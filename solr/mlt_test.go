@@ -0,0 +1,94 @@
+package solr
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestMoreLikeThisResponseDecode(t *testing.T) {
+	b := []byte(`{
+		"responseHeader": {"status": 0, "QTime": 1},
+		"match": {"numFound": 1, "start": 0, "docs": [{"id": "1"}]},
+		"response": {"numFound": 2, "start": 0, "docs": [{"id": "2"}, {"id": "3"}]},
+		"interestingTerms": ["body:solr", 1.0, "body:search", 0.5],
+		"moreLikeThis": {"1": {"numFound": 1, "start": 0, "docs": [{"id": "2"}]}}
+	}`)
+
+	var response Response
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatalf("failed to decode more like this response %v", err)
+	}
+
+	if response.Match.NumFound != 1 || response.Response.NumFound != 2 {
+		t.Errorf("failed to decode match %v or response %v", response.Match, response.Response)
+	}
+
+	if len(response.InterestingTerms) != 2 || response.InterestingTerms[1] != (InterestingTerm{Term: "body:search", Boost: 0.5}) {
+		t.Errorf("failed to decode interesting terms %v", response.InterestingTerms)
+	}
+
+	if response.MoreLikeThis["1"].Docs[0]["id"] != "2" {
+		t.Errorf("failed to decode more like this %v", response.MoreLikeThis)
+	}
+}
+
+func TestInterestingTermsList(t *testing.T) {
+	var terms InterestingTerms
+	if err := json.Unmarshal([]byte(`["body:solr", "body:search"]`), &terms); err != nil {
+		t.Fatalf("failed to decode interesting terms %v", err)
+	}
+
+	if len(terms) != 2 || terms[0].Term != "body:solr" || terms[0].Boost != 0 {
+		t.Errorf("failed to list interesting terms %v", terms)
+	}
+}
+
+func TestInterestingTermsDetailsOrder(t *testing.T) {
+	var terms InterestingTerms
+	if err := json.Unmarshal([]byte(`{"body:zebra": 3.0, "body:apple": 2.0, "body:mango": 1.0}`), &terms); err != nil {
+		t.Fatalf("failed to decode interesting terms %v", err)
+	}
+
+	expected := InterestingTerms{{Term: "body:zebra", Boost: 3}, {Term: "body:apple", Boost: 2}, {Term: "body:mango", Boost: 1}}
+	if !reflect.DeepEqual(terms, expected) {
+		t.Errorf("failed to keep the order of interesting terms %v", terms)
+	}
+}
+
+func TestMoreLikeThisParameters(t *testing.T) {
+	params, err := query.Values(SelectParameters{
+		Query: "id:1",
+		MoreLikeThisComponent: MoreLikeThisComponent{
+			Enable: true,
+			Count:  3,
+			MoreLikeThisParameters: MoreLikeThisParameters{
+				Fields:           []string{"title", "body"},
+				MinTermFreq:      1,
+				InterestingTerms: InterestingTermsDetails,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to encode parameters %v", err)
+	}
+
+	expected := "mlt=true&mlt.count=3&mlt.fl=title%2Cbody&mlt.interestingTerms=details&mlt.mintf=1&q=id%3A1"
+	if params.Encode() != expected {
+		t.Errorf("failed to encode parameters %v", params.Encode())
+	}
+}
+
+func TestMoreLikeThisQuery(t *testing.T) {
+	q := MoreLikeThisQuery{
+		QueryFields: []string{"title", "body"},
+		MinTermFreq: 1,
+		MinDocFreq:  2,
+	}.Query("doc-1")
+
+	if q != "{!mlt qf=title,body mintf=1 mindf=2}doc-1" {
+		t.Errorf("failed to build query %v", q)
+	}
+}
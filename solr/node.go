@@ -0,0 +1,338 @@
+// https://lucene.apache.org/solr/guide/8_5/cluster-node-management.html
+package solr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+type CollectionDeleteNode struct {
+	collectionBase
+
+	// The node to be cleaned up, e.g. 192.167.1.2:8983_solr. This parameter is required.
+	Node string `url:"node,omitempty"`
+
+	// Request ID to track this action which will be processed asynchronously.
+	Async string `url:"async,omitempty"`
+}
+
+type CollectionReplaceNode struct {
+	collectionBase
+
+	// The node whose replicas are moved. This parameter is required.
+	SourceNode string `url:"sourceNode,omitempty"`
+
+	// The node the replicas are moved to. By default the replicas are spread across the other
+	// live nodes.
+	TargetNode string `url:"targetNode,omitempty"`
+
+	// If true, the replicas are created on the target node in parallel.
+	Parallel bool `url:"parallel,omitempty"`
+
+	// The number of seconds to wait for each replica to become active. The default is 300.
+	Timeout int `url:"timeout,omitempty"`
+
+	// Request ID to track this action which will be processed asynchronously.
+	Async string `url:"async,omitempty"`
+}
+
+type DecommissionOptions struct {
+	// The nodes the replicas may be moved to. By default the replicas are moved to the other
+	// live nodes.
+	TargetNodes []string
+
+	// The interval between two status requests while waiting for the moves. The default is
+	// one second.
+	PollInterval time.Duration
+
+	// The maximum time to wait for the moved replicas to become active once the moves are done,
+	// in addition to the deadline of the context.
+	Timeout time.Duration
+
+	// Called after each replica move, with the number of moves done so far and the total.
+	Progress func(move ReplicaMove, done int, total int)
+}
+
+// A replica moved from one node to another.
+type ReplicaMove struct {
+	Collection string
+	Shard      string
+	Replica    string
+	SourceNode string
+	TargetNode string
+}
+
+// Returned by DecommissionNode when the replicas of the node can't be safely moved.
+type NodeDecommissionError struct {
+	Node     string
+	Problems []string
+}
+
+func (e *NodeDecommissionError) Error() string {
+	return fmt.Sprintf("solr: node %s can't be decommissioned: %s", e.Node, strings.Join(e.Problems, "; "))
+}
+
+// DeleteNode: Delete Replicas in a Node
+// Deletes all replicas of all collections in that node. Please note that the node itself will
+// remain as a live node after this operation.
+func (c *CollectionAPI) DeleteNode(ctx context.Context, collection CollectionDeleteNode) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = DeleteNodeAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// ReplaceNode: Move All Replicas in a Node to Another
+// Recreates all the replicas of a node on other nodes, then deletes them from the source node.
+func (c *CollectionAPI) ReplaceNode(ctx context.Context, collection CollectionReplaceNode) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = ReplaceNodeAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// DecommissionNode: Drain a Node before retiring it
+// Every replica of the node is checked to have an active copy on another live node, then the
+// replicas are moved one at a time with MoveReplica to the least loaded target node not already
+// hosting their shard. Once the moves are done, it waits for the node to hold no replica and for
+// each moved replica to be active on its target node, a *WaitTimeoutError is returned when the
+// timeout or the context deadline is reached first. The moves done are returned, even when a
+// later step fails.
+func (c *CollectionAPI) DecommissionNode(ctx context.Context, node string, options DecommissionOptions) ([]ReplicaMove, error) {
+	if options.PollInterval <= 0 {
+		options.PollInterval = defaultPollInterval
+	}
+
+	response, err := c.ClusterStatus(ctx, CollectionClusterStatus{})
+	if err != nil {
+		return nil, err
+	}
+	if err := response.Err(); err != nil {
+		return nil, err
+	}
+
+	planned, err := planDecommission(response.Cluster, node, options.TargetNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	var moves []ReplicaMove
+	for _, move := range planned {
+		if err := c.moveReplica(ctx, move, options.PollInterval); err != nil {
+			return moves, err
+		}
+
+		moves = append(moves, move)
+		if options.Progress != nil {
+			options.Progress(move, len(moves), len(planned))
+		}
+	}
+
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	err = c.waitForCluster(ctx, options.PollInterval, func(state ClusterState) error {
+		if remaining := nodeReplicas(state, node); len(remaining) > 0 {
+			return fmt.Errorf("solr: node %s still holds %d replicas", node, len(remaining))
+		}
+
+		return checkMoves(state, moves)
+	})
+
+	return moves, err
+}
+
+// checkMoves checks that the shard of each move has an active replica on the live target node.
+func checkMoves(state ClusterState, moves []ReplicaMove) error {
+	var pending []string
+	for _, move := range moves {
+		active := false
+		for _, replica := range state.Collections[move.Collection].Shards[move.Shard].Replicas {
+			if replica.NodeName == move.TargetNode && replica.State == ReplicaActive && state.IsLive(replica.NodeName) {
+				active = true
+				break
+			}
+		}
+		if !active {
+			pending = append(pending, fmt.Sprintf("%s/%s on %s", move.Collection, move.Shard, move.TargetNode))
+		}
+	}
+
+	if len(pending) > 0 {
+		return fmt.Errorf("solr: moved replicas not active yet: %s", strings.Join(pending, ", "))
+	}
+
+	return nil
+}
+
+// moveReplica moves a replica asynchronously and waits for the move to complete.
+func (c *CollectionAPI) moveReplica(ctx context.Context, move ReplicaMove, pollInterval time.Duration) error {
+	id := NewAsyncID()
+
+	response, err := c.MoveReplica(ctx, CollectionMoveReplica{
+		Collection: move.Collection,
+		Replica:    move.Replica,
+		TargetNode: move.TargetNode,
+		Async:      id,
+	})
+	if err != nil {
+		return err
+	}
+	if err := response.Err(); err != nil {
+		return err
+	}
+
+	response, err = c.WaitForAsync(ctx, id, pollInterval)
+	if err != nil {
+		return err
+	}
+	if response.Status.State != AsyncCompleted {
+		if err := response.Err(); err != nil {
+			return err
+		}
+		return fmt.Errorf("solr: move of %s/%s/%s to %s %s: %s", move.Collection, move.Shard, move.Replica, move.TargetNode, response.Status.State, response.Status.Msg)
+	}
+
+	return nil
+}
+
+// planDecommission checks that every replica of the node, except those of inactive shards, has an
+// active copy elsewhere and picks the target node of each replica.
+func planDecommission(state ClusterState, node string, targets []string) ([]ReplicaMove, error) {
+	if len(targets) == 0 {
+		targets = state.LiveNodes
+	}
+
+	var candidates []string
+	for _, target := range targets {
+		if target != node && state.IsLive(target) {
+			candidates = append(candidates, target)
+		}
+	}
+	sort.Strings(candidates)
+
+	moves := nodeReplicas(state, node)
+	if len(moves) > 0 && len(candidates) == 0 {
+		return nil, &NodeDecommissionError{Node: node, Problems: []string{"no live node to move the replicas to"}}
+	}
+
+	load := map[string]int{}
+	for _, collection := range state.Collections {
+		for _, shard := range collection.Shards {
+			for _, replica := range shard.Replicas {
+				load[replica.NodeName]++
+			}
+		}
+	}
+
+	// The nodes hosting each shard, including the targets already picked for its replicas.
+	shardHosts := map[string]map[string]bool{}
+
+	var problems []string
+	for i, move := range moves {
+		shard := state.Collections[move.Collection].Shards[move.Shard]
+
+		key := move.Collection + "/" + move.Shard
+		hosts, ok := shardHosts[key]
+		if !ok {
+			hosts = map[string]bool{}
+			for _, replica := range shard.Replicas {
+				hosts[replica.NodeName] = true
+			}
+			shardHosts[key] = hosts
+		}
+
+		healthy := false
+		for name, replica := range shard.Replicas {
+			if name != move.Replica && replica.NodeName != node && replica.State == ReplicaActive && state.IsLive(replica.NodeName) {
+				healthy = true
+			}
+		}
+		if shard.State != ShardInactive && !healthy {
+			problems = append(problems, fmt.Sprintf("%s/%s/%s: no active copy on another node", move.Collection, move.Shard, move.Replica))
+		}
+
+		// The least loaded candidate not hosting the shard yet, any candidate otherwise.
+		target := ""
+		for _, candidate := range candidates {
+			switch {
+			case target == "":
+				target = candidate
+			case hosts[candidate] != hosts[target]:
+				if !hosts[candidate] {
+					target = candidate
+				}
+			case load[candidate] < load[target]:
+				target = candidate
+			}
+		}
+
+		moves[i].TargetNode = target
+		hosts[target] = true
+		load[target]++
+	}
+
+	if len(problems) > 0 {
+		return nil, &NodeDecommissionError{Node: node, Problems: problems}
+	}
+
+	return moves, nil
+}
+
+// nodeReplicas returns the replicas hosted by the node, sorted by collection, shard and replica.
+func nodeReplicas(state ClusterState, node string) []ReplicaMove {
+	names := make([]string, 0, len(state.Collections))
+	for name := range state.Collections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var replicas []ReplicaMove
+	for _, name := range names {
+		collection := state.Collections[name]
+		for _, shardName := range collection.ShardNames() {
+			shard := collection.Shards[shardName]
+
+			replicaNames := make([]string, 0, len(shard.Replicas))
+			for replicaName, replica := range shard.Replicas {
+				if replica.NodeName == node {
+					replicaNames = append(replicaNames, replicaName)
+				}
+			}
+			sort.Strings(replicaNames)
+
+			for _, replicaName := range replicaNames {
+				replicas = append(replicas, ReplicaMove{Collection: name, Shard: shardName, Replica: replicaName, SourceNode: node})
+			}
+		}
+	}
+
+	return replicas
+}
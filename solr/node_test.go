@@ -0,0 +1,174 @@
+package solr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPlanDecommission(t *testing.T) {
+	moves, err := planDecommission(testClusterState(), "a:8983_solr", nil)
+	if err != nil {
+		t.Fatalf("failed to plan decommission %v", err)
+	}
+
+	expected := []ReplicaMove{
+		{Collection: "events", Shard: "shard1", Replica: "core_node1", SourceNode: "a:8983_solr", TargetNode: "c:8983_solr"},
+		{Collection: "logs", Shard: "shard1", Replica: "core_node3", SourceNode: "a:8983_solr", TargetNode: "b:8983_solr"},
+	}
+	if !reflect.DeepEqual(moves, expected) {
+		t.Errorf("failed to plan decommission %+v", moves)
+	}
+
+	state := testClusterState()
+	state.Collections["logs"].Shards["shard1"].Replicas["core_node4"] = Replica{NodeName: "c:8983_solr", State: ReplicaRecovering}
+
+	_, err = planDecommission(state, "a:8983_solr", nil)
+	var decommissionErr *NodeDecommissionError
+	if !errors.As(err, &decommissionErr) {
+		t.Fatalf("failed to refuse moving the only active copy %v", err)
+	}
+	if !reflect.DeepEqual(decommissionErr.Problems, []string{"logs/shard1/core_node3: no active copy on another node"}) {
+		t.Errorf("failed to report the replica without active copy %v", decommissionErr.Problems)
+	}
+}
+
+func TestPlanDecommissionSameShard(t *testing.T) {
+	state := ClusterState{
+		LiveNodes: []string{"a:8983_solr", "b:8983_solr", "c:8983_solr", "d:8983_solr"},
+		Collections: map[string]CollectionState{
+			"events": {Shards: map[string]Shard{
+				"shard1": {State: ShardActive, Replicas: map[string]Replica{
+					"core_node1": {NodeName: "a:8983_solr", State: ReplicaActive, Leader: true},
+					"core_node2": {NodeName: "a:8983_solr", State: ReplicaActive},
+					"core_node3": {NodeName: "b:8983_solr", State: ReplicaActive},
+				}},
+			}},
+			// d:8983_solr is the most loaded target, the least loaded one already gets a replica of the shard.
+			"logs": {Shards: map[string]Shard{
+				"shard1": {State: ShardActive, Replicas: map[string]Replica{
+					"core_node4": {NodeName: "d:8983_solr", State: ReplicaActive, Leader: true},
+				}},
+				"shard2": {State: ShardActive, Replicas: map[string]Replica{
+					"core_node5": {NodeName: "d:8983_solr", State: ReplicaActive, Leader: true},
+				}},
+			}},
+		},
+	}
+
+	moves, err := planDecommission(state, "a:8983_solr", nil)
+	if err != nil {
+		t.Fatalf("failed to plan decommission %v", err)
+	}
+
+	if len(moves) != 2 || moves[0].TargetNode == moves[1].TargetNode {
+		t.Errorf("failed to spread the replicas of a shard across targets %+v", moves)
+	}
+}
+
+func TestPlanDecommissionShardStates(t *testing.T) {
+	for _, shardState := range []ShardState{ShardConstruction, ShardRecovery, ShardInactive} {
+		state := testClusterState()
+		state.Collections["logs"] = CollectionState{Shards: map[string]Shard{
+			"shard1": {State: shardState, Replicas: map[string]Replica{
+				"core_node3": {NodeName: "a:8983_solr", State: ReplicaActive, Leader: true},
+			}},
+		}}
+
+		_, err := planDecommission(state, "a:8983_solr", nil)
+		if shardState == ShardInactive {
+			if err != nil {
+				t.Errorf("failed to plan decommission of an inactive shard %v", err)
+			}
+			continue
+		}
+
+		var decommissionErr *NodeDecommissionError
+		if !errors.As(err, &decommissionErr) {
+			t.Errorf("failed to refuse moving the only copy of a %s shard %v", shardState, err)
+		}
+	}
+}
+
+// movedState returns the test cluster state once the replicas of a:8983_solr are moved, the
+// moved replicas being in the given state.
+func movedState(state ReplicaState) ClusterState {
+	cluster := testClusterState()
+	cluster.Collections["events"].Shards["shard1"].Replicas["core_node1"] = Replica{NodeName: "c:8983_solr", State: state, Leader: true}
+	cluster.Collections["logs"].Shards["shard1"].Replicas["core_node3"] = Replica{NodeName: "b:8983_solr", State: state}
+
+	return cluster
+}
+
+func newDecommissionClient(state ReplicaState, moved *[]string) (*Client, *httptest.Server) {
+	return newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+
+		switch params.Get("action") {
+		case "CLUSTERSTATUS":
+			cluster := testClusterState()
+			if len(*moved) == 2 {
+				cluster = movedState(state)
+			}
+			// A down replica of an unrelated collection doesn't hold the decommission.
+			cluster.Collections["metrics"] = CollectionState{Shards: map[string]Shard{
+				"shard1": {State: ShardActive, Replicas: map[string]Replica{
+					"core_node5": {NodeName: "b:8983_solr", State: ReplicaDown},
+				}},
+			}}
+			writeClusterStatus(w, cluster)
+		case "MOVEREPLICA":
+			*moved = append(*moved, params.Get("replica")+"->"+params.Get("targetNode"))
+			_, _ = w.Write([]byte(`{"responseHeader": {"status": 0}}`))
+		case "REQUESTSTATUS":
+			_, _ = w.Write([]byte(`{"responseHeader": {"status": 0}, "status": {"state": "completed"}}`))
+		}
+	})
+}
+
+func TestDecommissionNode(t *testing.T) {
+	var moved []string
+	client, server := newDecommissionClient(ReplicaActive, &moved)
+	defer server.Close()
+
+	var progress []int
+	moves, err := client.Collection.DecommissionNode(context.Background(), "a:8983_solr", DecommissionOptions{
+		PollInterval: time.Millisecond,
+		Timeout:      time.Second,
+		Progress: func(move ReplicaMove, done int, total int) {
+			progress = append(progress, done, total)
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to decommission node %v", err)
+	}
+
+	if len(moves) != 2 || !reflect.DeepEqual(moved, []string{"core_node1->c:8983_solr", "core_node3->b:8983_solr"}) {
+		t.Errorf("failed to move replicas %v", moved)
+	}
+	if !reflect.DeepEqual(progress, []int{1, 2, 2, 2}) {
+		t.Errorf("failed to report progress %v", progress)
+	}
+}
+
+func TestDecommissionNodeTimeout(t *testing.T) {
+	var moved []string
+	client, server := newDecommissionClient(ReplicaRecovering, &moved)
+	defer server.Close()
+
+	moves, err := client.Collection.DecommissionNode(context.Background(), "a:8983_solr", DecommissionOptions{
+		PollInterval: time.Millisecond,
+		Timeout:      20 * time.Millisecond,
+	})
+	var timeoutErr *WaitTimeoutError
+	if !errors.As(err, &timeoutErr) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("failed to time out waiting for the moved replicas %v", err)
+	}
+	if len(moves) != 2 {
+		t.Errorf("failed to return the moves done %v", moves)
+	}
+}
@@ -0,0 +1,286 @@
+// https://lucene.apache.org/solr/guide/8_5/replica-management.html
+package solr
+
+import (
+	"context"
+	"net/http"
+)
+
+// The replica property used by RebalanceLeaders to select the leader of each shard.
+const PreferredLeaderProperty = "preferredLeader"
+
+type CollectionAddReplica struct {
+	collectionBase
+
+	// The name of the collection where the replica should be created. This parameter is required.
+	Collection string `url:"collection,omitempty"`
+
+	// The name of the shard to which the replica should be added. This parameter is required when
+	// _route_ is not specified.
+	Shard string `url:"shard,omitempty"`
+
+	// The route key, used to identify the shard instead of its name.
+	Route string `url:"_route_,omitempty"`
+
+	// The node the replica should be created on, e.g. 192.167.1.2:8983_solr.
+	Node string `url:"node,omitempty"`
+
+	// The nodes the replicas may be created on, as a comma-separated list. Ignored when node is
+	// specified.
+	CreateNodeSet []string `url:"createNodeSet,comma,omitempty"`
+
+	// The directories of the core of the replica, the defaults of the node are used when empty.
+	InstanceDir string `url:"instanceDir,omitempty"`
+	DataDir     string `url:"dataDir,omitempty"`
+	ULogDir     string `url:"ulogDir,omitempty"`
+
+	// The type of the replica, NRTReplica, TLOGReplica or PULLReplica. The default is NRTReplica.
+	Type ReplicaType `url:"type,omitempty"`
+
+	// The number of replicas of each type to add at once, instead of a single replica of Type.
+	NrtReplicas  int `url:"nrtReplicas,omitempty"`
+	TLogReplicas int `url:"tlogReplicas,omitempty"`
+	PullReplicas int `url:"pullReplicas,omitempty"`
+
+	// The core properties of the replica, sent as property.<name>=<value>.
+	Properties CoreProperties `url:"property,omitempty"`
+
+	// If true, the request will complete only when all affected replicas become active.
+	WaitForFinalState bool `url:"waitForFinalState,omitempty"`
+
+	// Request ID to track this action which will be processed asynchronously.
+	Async string `url:"async,omitempty"`
+}
+
+type CollectionDeleteReplica struct {
+	collectionBase
+
+	// The name of the collection. This parameter is required.
+	Collection string `url:"collection,omitempty"`
+
+	// The name of the shard that includes the replica to be removed. This parameter is required
+	// unless only a count is given, in which case replicas are removed from every shard.
+	Shard string `url:"shard,omitempty"`
+
+	// The name of the replica to remove, e.g. core_node3. This parameter is required when count is
+	// not specified.
+	Replica string `url:"replica,omitempty"`
+
+	// The number of replicas to remove. Solr removes replicas that are down first, it never removes
+	// the last replica of a shard.
+	Count int `url:"count,omitempty"`
+
+	// By default Solr deletes the instance directory, the data directory and the index of the
+	// replica, set the matching option to false to keep them.
+	DeleteInstanceDir *bool `url:"deleteInstanceDir,omitempty"`
+	DeleteDataDir     *bool `url:"deleteDataDir,omitempty"`
+	DeleteIndex       *bool `url:"deleteIndex,omitempty"`
+
+	// If true, the replica is only deleted when it is not active, e.g. when its node is gone.
+	OnlyIfDown bool `url:"onlyIfDown,omitempty"`
+
+	// Request ID to track this action which will be processed asynchronously.
+	Async string `url:"async,omitempty"`
+}
+
+type CollectionMoveReplica struct {
+	collectionBase
+
+	// The name of the collection. This parameter is required.
+	Collection string `url:"collection,omitempty"`
+
+	// The name of the shard the replica belongs to. Required with sourceNode.
+	Shard string `url:"shard,omitempty"`
+
+	// The name of the replica to move. This parameter is required when sourceNode is not specified.
+	Replica string `url:"replica,omitempty"`
+
+	// The node holding the replica to move, along with shard, instead of the replica name.
+	SourceNode string `url:"sourceNode,omitempty"`
+
+	// The node the replica is moved to. This parameter is required.
+	TargetNode string `url:"targetNode,omitempty"`
+
+	// The number of seconds to wait for the new replica to become active before failing. The
+	// default is 600.
+	Timeout int `url:"timeout,omitempty"`
+
+	// When the replica uses a shared file system, e.g. HDFS, whether the index is reused in place
+	// rather than copied. The default is true.
+	InPlaceMove *bool `url:"inPlaceMove,omitempty"`
+
+	// Request ID to track this action which will be processed asynchronously.
+	Async string `url:"async,omitempty"`
+}
+
+type CollectionAddReplicaProp struct {
+	collectionBase
+
+	// The name of the collection the replica belongs to. This parameter is required.
+	Collection string `url:"collection,omitempty"`
+
+	// The name of the shard the replica belongs to. This parameter is required.
+	Shard string `url:"shard,omitempty"`
+
+	// The replica, e.g. core_node1. This parameter is required.
+	Replica string `url:"replica,omitempty"`
+
+	// The name of the property, e.g. PreferredLeaderProperty. Solr prefixes it with property. when
+	// missing. This parameter is required.
+	Property string `url:"property,omitempty"`
+
+	// The value of the property. This parameter is required.
+	PropertyValue string `url:"property.value,omitempty"`
+
+	// If true, the property is removed from the other replicas of the shard. This is implied for
+	// preferredLeader.
+	ShardUnique bool `url:"shardUnique,omitempty"`
+}
+
+type CollectionDeleteReplicaProp struct {
+	collectionBase
+
+	// The name of the collection the replica belongs to. This parameter is required.
+	Collection string `url:"collection,omitempty"`
+
+	// The name of the shard the replica belongs to. This parameter is required.
+	Shard string `url:"shard,omitempty"`
+
+	// The replica, e.g. core_node1. This parameter is required.
+	Replica string `url:"replica,omitempty"`
+
+	// The name of the property to remove. This parameter is required.
+	Property string `url:"property,omitempty"`
+}
+
+type CollectionBalanceShardUnique struct {
+	collectionBase
+
+	// The name of the collection to balance the property in. This parameter is required.
+	Collection string `url:"collection,omitempty"`
+
+	// The property to balance, e.g. PreferredLeaderProperty. This parameter is required.
+	Property string `url:"property,omitempty"`
+
+	// By default the property is only assigned to active replicas, set to false to also consider
+	// the replicas that are not active.
+	OnlyActiveNodes *bool `url:"onlyactivenodes,omitempty"`
+
+	// Required to balance properties other than preferredLeader, which has to be unique per shard.
+	ShardUnique bool `url:"shardUnique,omitempty"`
+}
+
+// AddReplica: Add Replica
+// Adds one or more replicas to a shard of a collection.
+func (c *CollectionAPI) AddReplica(ctx context.Context, collection CollectionAddReplica) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = AddReplicaAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// DeleteReplica: Delete a Replica
+// Deletes a named replica, or a number of replicas, from a shard of a collection.
+func (c *CollectionAPI) DeleteReplica(ctx context.Context, collection CollectionDeleteReplica) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = DeleteReplicaAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// MoveReplica: Move a Replica to a New Node
+// Adds a replica on the target node, then deletes the source replica once the new one is active.
+func (c *CollectionAPI) MoveReplica(ctx context.Context, collection CollectionMoveReplica) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = MoveReplicaAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// AddReplicaProp: Add Replica Property
+// Assigns a property to a replica, e.g. preferredLeader before calling RebalanceLeaders.
+func (c *CollectionAPI) AddReplicaProp(ctx context.Context, collection CollectionAddReplicaProp) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = AddReplicaPropAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// DeleteReplicaProp: Delete Replica Property
+// Removes a property from a replica.
+func (c *CollectionAPI) DeleteReplicaProp(ctx context.Context, collection CollectionDeleteReplicaProp) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = DeleteReplicaPropAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// BalanceShardUnique: Balance a Property Across Nodes
+// Assigns a property to exactly one replica of each shard, spreading the replicas holding it
+// evenly across the nodes, e.g. preferredLeader before calling RebalanceLeaders.
+func (c *CollectionAPI) BalanceShardUnique(ctx context.Context, collection CollectionBalanceShardUnique) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = BalanceShardUniqueAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
@@ -0,0 +1,88 @@
+package solr
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestReplicaParameters(t *testing.T) {
+	values, err := query.Values(CollectionAddReplica{
+		Collection:    "events",
+		Shard:         "shard1",
+		Type:          PULLReplica,
+		CreateNodeSet: []string{"127.0.0.1:8983_solr", "127.0.0.1:7574_solr"},
+		Properties:    CoreProperties{"rack": "a"},
+	})
+	if err != nil {
+		t.Errorf("failed to encode add replica parameters %v", err)
+	}
+
+	expected := "collection=events&createNodeSet=127.0.0.1%3A8983_solr%2C127.0.0.1%3A7574_solr&property.rack=a&shard=shard1&type=PULL"
+	if values.Encode() != expected {
+		t.Errorf("failed to encode add replica parameters %v", values.Encode())
+	}
+
+	values, err = query.Values(CollectionDeleteReplica{Collection: "events", Shard: "shard1", Count: 2, OnlyIfDown: true})
+	if err != nil {
+		t.Errorf("failed to encode delete replica parameters %v", err)
+	}
+
+	if values.Encode() != "collection=events&count=2&onlyIfDown=true&shard=shard1" {
+		t.Errorf("failed to encode delete replica parameters %v", values.Encode())
+	}
+}
+
+func TestReplicaActions(t *testing.T) {
+	var actions []string
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+		actions = append(actions, params.Get("action"))
+
+		if params.Get("action") == "ADDREPLICAPROP" {
+			if params.Get("property") != "preferredLeader" || params.Get("property.value") != "true" || params.Get("replica") != "core_node2" {
+				t.Errorf("failed to add preferred leader property %v", r.URL)
+			}
+		}
+		_, _ = w.Write([]byte(`{"responseHeader": {"status": 0}}`))
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	if _, err := client.Collection.AddReplica(ctx, CollectionAddReplica{Collection: "events", Shard: "shard1"}); err != nil {
+		t.Fatalf("failed to add replica %v", err)
+	}
+	if _, err := client.Collection.MoveReplica(ctx, CollectionMoveReplica{Collection: "events", Replica: "core_node1", TargetNode: "127.0.0.1:7574_solr"}); err != nil {
+		t.Fatalf("failed to move replica %v", err)
+	}
+	if _, err := client.Collection.AddReplicaProp(ctx, CollectionAddReplicaProp{
+		Collection:    "events",
+		Shard:         "shard1",
+		Replica:       "core_node2",
+		Property:      PreferredLeaderProperty,
+		PropertyValue: "true",
+	}); err != nil {
+		t.Fatalf("failed to add replica property %v", err)
+	}
+	if _, err := client.Collection.DeleteReplicaProp(ctx, CollectionDeleteReplicaProp{Collection: "events", Shard: "shard1", Replica: "core_node2", Property: PreferredLeaderProperty}); err != nil {
+		t.Fatalf("failed to delete replica property %v", err)
+	}
+	if _, err := client.Collection.BalanceShardUnique(ctx, CollectionBalanceShardUnique{Collection: "events", Property: PreferredLeaderProperty}); err != nil {
+		t.Fatalf("failed to balance shard unique %v", err)
+	}
+	if _, err := client.Collection.DeleteReplica(ctx, CollectionDeleteReplica{Collection: "events", Shard: "shard1", Replica: "core_node1"}); err != nil {
+		t.Fatalf("failed to delete replica %v", err)
+	}
+
+	expected := []string{"ADDREPLICA", "MOVEREPLICA", "ADDREPLICAPROP", "DELETEREPLICAPROP", "BALANCESHARDUNIQUE", "DELETEREPLICA"}
+	if len(actions) != len(expected) {
+		t.Fatalf("failed to send replica actions %v", actions)
+	}
+	for i := range expected {
+		if actions[i] != expected[i] {
+			t.Errorf("failed to send replica actions %v", actions)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package solr
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
+)
 
 type Response struct {
 	HttpResponse       *http.Response
@@ -8,13 +11,43 @@ type Response struct {
 	Collections        []*string              `json:"collections,omitempty"`
 	ConfigSets         []*string              `json:"configSets,omitempty"`
 	Response           Result                 `json:"response,omitempty"`
+	Match              Result                 `json:"match,omitempty"`
+	MoreLikeThis       map[string]Result      `json:"moreLikeThis,omitempty"`
+	InterestingTerms   InterestingTerms       `json:"interestingTerms,omitempty"`
+	Grouped            map[string]GroupResult `json:"grouped,omitempty"`
+	Expanded           map[string]Result      `json:"expanded,omitempty"`
+	Stats              Stats                  `json:"stats,omitempty"`
+	Facets             map[string]interface{} `json:"facets,omitempty"`
+	Debug              DebugInfo              `json:"debug,omitempty"`
+	Analysis           Analysis               `json:"analysis,omitempty"`
+	FeatureStores      []string               `json:"featureStores,omitempty"`
+	Features           []Feature              `json:"features,omitempty"`
+	Models             []Model                `json:"models,omitempty"`
 	Error              Error                  `json:"error,omitempty"`
 	Exception          Exception              `json:"exception,omitempty"`
-	Terms              map[string]interface{} `json:"terms,omitempty"`
+	Terms              TermsResponse          `json:"terms,omitempty"`
+	IndexStats         IndexStats             `json:"indexstats,omitempty"`
+	Suggest            SuggestResponse        `json:"suggest,omitempty"`
 	Schema             Schema                 `json:"schema,omitempty"`
 	Fields             []Field                `json:"fields,omitempty"`
+	Field              Field                  `json:"field,omitempty"`
 	DynamicFields      []Field                `json:"dynamicFields,omitempty"`
-	UniqueKey          []Field                `json:"uniqueKey,omitempty"`
+	DynamicField       Field                  `json:"dynamicField,omitempty"`
+	FieldTypes         []FieldType            `json:"fieldTypes,omitempty"`
+	FieldType          FieldType              `json:"fieldType,omitempty"`
+	CopyFields         []CopyField            `json:"copyFields,omitempty"`
+	UniqueKey          string                 `json:"uniqueKey,omitempty"`
+	Similarity         Factory                `json:"similarity,omitempty"`
+	Version            float32                `json:"version,omitempty"`
+	Name               string                 `json:"name,omitempty"`
+	Config             SolrConfig             `json:"config,omitempty"`
+	Overlay            ConfigOverlay          `json:"overlay,omitempty"`
+	Cluster            ClusterState           `json:"cluster,omitempty"`
+	Status             AsyncStatus            `json:"status,omitempty"`
+	Aliases            map[string]string      `json:"aliases,omitempty"`
+	Properties         AliasesProperties      `json:"properties,omitempty"`
+	Tree               []ZNodeTree            `json:"tree,omitempty"`
+	ZNode              ZNode                  `json:"znode,omitempty"`
 	ReindexStatus      ReindexStatus          `json:"reindexStatus,omitempty"`
 	GettingStarted     GettingStarted         `json:"gettingstarted,omitempty"`
 	Summary            map[string]interface{} `json:"Summary,omitempty"`
@@ -42,6 +75,9 @@ type Error struct {
 	Msg      string    `json:"msg,omitempty"`
 	Code     int       `json:"code,omitempty"`
 	Metadata []*string `json:"metadata,omitempty"`
+
+	// The failed commands of a multi-command request, e.g. a Schema API modification.
+	Details []CommandError `json:"details,omitempty"`
 }
 
 type Params struct {
@@ -56,21 +92,64 @@ type Exception struct {
 	RspCode int64  `json:"rspCode"`
 }
 
+// Err returns the error reported by Solr in the response, nil when the request succeeded.
+func (r *Response) Err() error {
+	if r.Error.Code != 0 || r.Error.Msg != "" {
+		return r.Error
+	}
+	if r.Exception.Msg != "" {
+		return fmt.Errorf("solr: %s (%d)", r.Exception.Msg, r.Exception.RspCode)
+	}
+
+	return nil
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("solr: %s (%d)", e.Msg, e.Code)
+}
+
 type Schema struct {
-	Name       string        `json:"name,omitempty"`
-	Version    float32       `json:"version,omitempty"`
-	UniqueKey  string        `json:"uniqueKey,omitempty"`
-	FieldTypes []interface{} `json:"fieldTypes,omitempty"`
+	Name          string      `json:"name,omitempty"`
+	Version       float32     `json:"version,omitempty"`
+	UniqueKey     string      `json:"uniqueKey,omitempty"`
+	Similarity    Factory     `json:"similarity,omitempty"`
+	FieldTypes    []FieldType `json:"fieldTypes,omitempty"`
+	Fields        []Field     `json:"fields,omitempty"`
+	DynamicFields []Field     `json:"dynamicFields,omitempty"`
+	CopyFields    []CopyField `json:"copyFields,omitempty"`
 }
 
 type Field struct {
-	Name                 string `json:"name,omitempty"`
-	Type                 string `json:"type,omitempty"`
-	MultiValued          bool   `json:"multiValued,omitempty"`
-	Indexed              bool   `json:"indexed,omitempty"`
-	Stored               bool   `json:"stored,omitempty"`
-	Required             bool   `json:"required,omitempty"`
-	UseDocValuesAsStored bool   `json:"useDocValuesAsStored,omitempty"`
+	Name                     string `json:"name,omitempty"`
+	Type                     string `json:"type,omitempty"`
+	Default                  string `json:"default,omitempty"`
+	MultiValued              *bool  `json:"multiValued,omitempty"`
+	Indexed                  *bool  `json:"indexed,omitempty"`
+	Stored                   *bool  `json:"stored,omitempty"`
+	Required                 *bool  `json:"required,omitempty"`
+	DocValues                *bool  `json:"docValues,omitempty"`
+	UseDocValuesAsStored     *bool  `json:"useDocValuesAsStored,omitempty"`
+	SortMissingFirst         *bool  `json:"sortMissingFirst,omitempty"`
+	SortMissingLast          *bool  `json:"sortMissingLast,omitempty"`
+	OmitNorms                *bool  `json:"omitNorms,omitempty"`
+	OmitTermFreqAndPositions *bool  `json:"omitTermFreqAndPositions,omitempty"`
+	OmitPositions            *bool  `json:"omitPositions,omitempty"`
+	TermVectors              *bool  `json:"termVectors,omitempty"`
+	TermPositions            *bool  `json:"termPositions,omitempty"`
+	TermOffsets              *bool  `json:"termOffsets,omitempty"`
+	TermPayloads             *bool  `json:"termPayloads,omitempty"`
+	Large                    *bool  `json:"large,omitempty"`
+	Uninvertible             *bool  `json:"uninvertible,omitempty"`
+}
+
+// Bool returns a pointer to b, used to set the optional properties of fields and field types.
+func Bool(b bool) *bool {
+	return &b
+}
+
+// Int returns a pointer to i, used to set the optional parameters for which 0 is a valid value.
+func Int(i int) *int {
+	return &i
 }
 
 type ReindexStatus struct {
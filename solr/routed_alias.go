@@ -0,0 +1,284 @@
+// https://lucene.apache.org/solr/guide/8_5/aliases.html#routed-aliases
+package solr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+const (
+	TimeRouter     = "time"
+	CategoryRouter = "category"
+)
+
+// The separators between the alias name and the route key of the collections of routed aliases.
+const (
+	timeRoutedSeparator     = "__TRA__"
+	categoryRoutedSeparator = "__CRA__"
+)
+
+// The layout of the start time in the collection names of time routed aliases, trailing
+// zero hours, minutes and seconds are omitted.
+const timeRoutedLayout = "2006-01-02_15_04_05"
+
+var unsafeCategoryChars = regexp.MustCompile(`\W`)
+
+// A date math expression adding or subtracting units, e.g. +1DAY, and each of its terms.
+var (
+	dateMathExpression = regexp.MustCompile(`^([+-]\d+[A-Z]+)+$`)
+	dateMathTerm       = regexp.MustCompile(`([+-])(\d+)([A-Z]+)`)
+)
+
+type AliasRouter struct {
+	// The type of routing to use, TimeRouter or CategoryRouter. Left empty for a dimensional
+	// routed alias, the name is built from the Dimensions, e.g. Dimensional[time,category].
+	Name string `url:"name,omitempty"`
+
+	// The field to inspect in each document to route it to a collection. This parameter is required.
+	Field string `url:"field,omitempty"`
+
+	// The start date/time of data for a time routed alias, in Solr’s standard date/time format,
+	// e.g. 2018-01-15T00:00:00Z, or NOW optionally with date math. This parameter is required
+	// for a time routed alias.
+	Start string `url:"start,omitempty"`
+
+	// A date math expression that will be appended to a timestamp to determine the next collection
+	// in the series, e.g. +1DAY. This parameter is required for a time routed alias.
+	Interval string `url:"interval,omitempty"`
+
+	// The maximum milliseconds into the future that a document is allowed to have in the router
+	// field for it to be accepted without error, the default is 10 minutes.
+	MaxFutureMs int64 `url:"maxFutureMs,omitempty"`
+
+	// A date math expression that triggers the asynchronous creation of the next collection when
+	// a document falls within that period before the end of the most recent collection, e.g. 90MINUTES.
+	PreemptiveCreateMath string `url:"preemptiveCreateMath,omitempty"`
+
+	// A date math expression deleting the oldest collections when their entire time range is
+	// older than the age, e.g. /DAY-90DAYS.
+	AutoDeleteAge string `url:"autoDeleteAge,omitempty"`
+
+	// The maximum number of categories allowed for a category routed alias.
+	MaxCardinality int `url:"maxCardinality,omitempty"`
+
+	// A regular expression the value of the field must match for a category routed alias.
+	MustMatch string `url:"mustMatch,omitempty"`
+
+	// The routers of each dimension of a dimensional routed alias, in order, sent as
+	// router.<index>.<param>.
+	Dimensions []AliasRouter `url:"-"`
+}
+
+// The parameters of the collections created by a routed alias. The name is generated by the
+// alias and must be left empty.
+type CreateCollectionParameters CollectionCreate
+
+type CollectionMaintainRoutedAlias struct {
+	collectionBase
+
+	// The name of the routed alias. This parameter is required.
+	Name string `url:"name,omitempty"`
+
+	// The value of the router field, the collection it falls into is created if missing.
+	RouteKey string `url:"routeKey,omitempty"`
+}
+
+// EncodeValues encodes the router as <key>.<param>, the dimensions of a dimensional routed alias
+// as <key>.<index>.<param>.
+func (r AliasRouter) EncodeValues(key string, v *url.Values) error {
+	if len(r.Dimensions) > 0 && r.Name == "" {
+		names := make([]string, 0, len(r.Dimensions))
+		for _, dimension := range r.Dimensions {
+			names = append(names, dimension.Name)
+		}
+		r.Name = "Dimensional[" + strings.Join(names, ",") + "]"
+	}
+
+	if err := addPrefixedValues(v, key, r); err != nil {
+		return err
+	}
+
+	for i, dimension := range r.Dimensions {
+		dimension.Name = ""
+		if err := dimension.EncodeValues(key+"."+strconv.Itoa(i), v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EncodeValues encodes the parameters as <key>.<param>, e.g. create-collection.numShards.
+func (p CreateCollectionParameters) EncodeValues(key string, v *url.Values) error {
+	p.Action = ""
+	p.WT = ""
+	p.Name = ""
+
+	return addPrefixedValues(v, key, CollectionCreate(p))
+}
+
+// addPrefixedValues encodes the struct params as query parameters prefixed by key.
+func addPrefixedValues(v *url.Values, key string, params interface{}) error {
+	values, err := query.Values(params)
+	if err != nil {
+		return err
+	}
+
+	for name, list := range values {
+		for _, value := range list {
+			v.Add(key+"."+name, value)
+		}
+	}
+
+	return nil
+}
+
+// TimeRoutedCollectionName returns the name of the collection of a time routed alias starting at
+// start, e.g. logs__TRA__2020-05-01 or logs__TRA__2020-05-01_06.
+func TimeRoutedCollectionName(alias string, start time.Time) string {
+	name := start.UTC().Format(timeRoutedLayout)
+	for i := 0; i < 3 && strings.HasSuffix(name, "_00"); i++ {
+		name = strings.TrimSuffix(name, "_00")
+	}
+
+	return alias + timeRoutedSeparator + name
+}
+
+// ParseTimeRoutedCollection returns the start time of a collection of a time routed alias.
+func ParseTimeRoutedCollection(alias string, collection string) (time.Time, error) {
+	prefix := alias + timeRoutedSeparator
+	if !strings.HasPrefix(collection, prefix) {
+		return time.Time{}, fmt.Errorf("solr: %s is not a collection of the time routed alias %s", collection, alias)
+	}
+
+	value := strings.TrimPrefix(collection, prefix)
+	layout := "2006-01-02"
+	for _, part := range []string{"_15", "_04", "_05"} {
+		if len(value) <= len(layout) {
+			break
+		}
+		layout += part
+	}
+
+	return time.Parse(layout, value)
+}
+
+// TimeRoutedCollection returns the collection of a time routed alias a timestamp is routed to,
+// the one whose period, from its start time to the start time plus the router interval, holds
+// the timestamp. The collections of the alias are listed by LISTALIASES or CLUSTERSTATUS,
+// collections of other aliases are ignored. A timestamp in the period following the one of the
+// most recent collection returns the name of the collection Solr would create for it, which
+// doesn't exist yet, see MaintainRoutedAlias. Later timestamps return an error.
+func TimeRoutedCollection(alias string, collections []string, interval string, t time.Time) (string, error) {
+	var routed string
+	var routedStart time.Time
+
+	for _, collection := range collections {
+		start, err := ParseTimeRoutedCollection(alias, collection)
+		if err != nil {
+			continue
+		}
+
+		if !start.After(t) && (routed == "" || start.After(routedStart)) {
+			routed, routedStart = collection, start
+		}
+	}
+
+	if routed == "" {
+		return "", fmt.Errorf("solr: %s is before the first collection of the time routed alias %s", t.UTC().Format(time.RFC3339), alias)
+	}
+
+	end, err := addDateMath(routedStart, interval)
+	if err != nil {
+		return "", err
+	}
+	if !end.After(routedStart) {
+		return "", fmt.Errorf("solr: the interval %s of the time routed alias %s is not positive", interval, alias)
+	}
+	if t.Before(end) {
+		return routed, nil
+	}
+
+	next, err := addDateMath(end, interval)
+	if err != nil {
+		return "", err
+	}
+	if t.Before(next) {
+		return TimeRoutedCollectionName(alias, end), nil
+	}
+
+	return "", fmt.Errorf("solr: %s is more than one interval after the collection %s of the time routed alias %s", t.UTC().Format(time.RFC3339), routed, alias)
+}
+
+// addDateMath adds a date math expression made of units to add or subtract to t, e.g. +1DAY or
+// +1HOUR+30MINUTES. Rounding, e.g. /DAY, is not supported.
+func addDateMath(t time.Time, math string) (time.Time, error) {
+	if !dateMathExpression.MatchString(math) {
+		return time.Time{}, fmt.Errorf("solr: invalid date math %q", math)
+	}
+
+	for _, term := range dateMathTerm.FindAllStringSubmatch(math, -1) {
+		n, err := strconv.Atoi(term[2])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("solr: invalid date math %q: %v", math, err)
+		}
+		if term[1] == "-" {
+			n = -n
+		}
+
+		switch term[3] {
+		case "YEAR", "YEARS":
+			t = t.AddDate(n, 0, 0)
+		case "MONTH", "MONTHS":
+			t = t.AddDate(0, n, 0)
+		case "DAY", "DAYS", "DATE":
+			t = t.AddDate(0, 0, n)
+		case "HOUR", "HOURS":
+			t = t.Add(time.Duration(n) * time.Hour)
+		case "MINUTE", "MINUTES":
+			t = t.Add(time.Duration(n) * time.Minute)
+		case "SECOND", "SECONDS":
+			t = t.Add(time.Duration(n) * time.Second)
+		case "MILLI", "MILLIS", "MILLISECOND", "MILLISECONDS":
+			t = t.Add(time.Duration(n) * time.Millisecond)
+		default:
+			return time.Time{}, fmt.Errorf("solr: invalid date math unit %s in %q", term[3], math)
+		}
+	}
+
+	return t, nil
+}
+
+// CategoryRoutedCollection returns the collection of a category routed alias a category is
+// routed to, e.g. products__CRA__home_garden. The characters other than letters, digits and
+// underscores are replaced by underscores, as done by Solr.
+func CategoryRoutedCollection(alias string, category string) string {
+	return alias + categoryRoutedSeparator + unsafeCategoryChars.ReplaceAllString(strings.TrimSpace(category), "_")
+}
+
+// MaintainRoutedAlias: Maintain a Routed Alias
+// Creates the collection a route key falls into if it doesn't exist, e.g. ahead of a bulk load.
+func (c *CollectionAPI) MaintainRoutedAlias(ctx context.Context, collection CollectionMaintainRoutedAlias) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = MaintainRoutedAliasAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
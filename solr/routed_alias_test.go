@@ -0,0 +1,132 @@
+package solr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestRoutedAliasParameters(t *testing.T) {
+	values, err := query.Values(CollectionCreateAlias{
+		collectionBase: collectionBase{Action: CreateAliasAction},
+		Name:           "logs",
+		Router: &AliasRouter{
+			Name:          TimeRouter,
+			Field:         "timestamp_dt",
+			Start:         "2020-05-01T00:00:00Z",
+			Interval:      "+1DAY",
+			MaxFutureMs:   3600000,
+			AutoDeleteAge: "/DAY-90DAYS",
+		},
+		CreateCollection: &CreateCollectionParameters{
+			Name:                 "ignored",
+			NumShards:            2,
+			CollectionConfigName: "logs",
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to encode routed alias parameters %v", err)
+	}
+
+	expected := "action=CREATEALIAS&create-collection.collection.configName=logs&create-collection.numShards=2" +
+		"&name=logs&router.autoDeleteAge=%2FDAY-90DAYS&router.field=timestamp_dt&router.interval=%2B1DAY" +
+		"&router.maxFutureMs=3600000&router.name=time&router.start=2020-05-01T00%3A00%3A00Z"
+	if values.Encode() != expected {
+		t.Errorf("failed to encode routed alias parameters %v", values.Encode())
+	}
+}
+
+func TestDimensionalRoutedAliasParameters(t *testing.T) {
+	values, err := query.Values(CollectionCreateAlias{
+		Name: "logs",
+		Router: &AliasRouter{Dimensions: []AliasRouter{
+			{Name: TimeRouter, Field: "timestamp_dt", Start: "NOW/DAY", Interval: "+1DAY"},
+			{Name: CategoryRouter, Field: "source_s", MaxCardinality: 20},
+		}},
+	})
+	if err != nil {
+		t.Errorf("failed to encode dimensional routed alias parameters %v", err)
+	}
+
+	expected := "name=logs&router.0.field=timestamp_dt&router.0.interval=%2B1DAY&router.0.start=NOW%2FDAY" +
+		"&router.1.field=source_s&router.1.maxCardinality=20&router.name=Dimensional%5Btime%2Ccategory%5D"
+	if values.Encode() != expected {
+		t.Errorf("failed to encode routed alias parameters %v", values.Encode())
+	}
+}
+
+func TestTimeRoutedCollectionName(t *testing.T) {
+	tests := map[string]time.Time{
+		"logs__TRA__2020-05-01":          time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC),
+		"logs__TRA__2020-05-01_06":       time.Date(2020, 5, 1, 6, 0, 0, 0, time.UTC),
+		"logs__TRA__2020-05-01_06_30":    time.Date(2020, 5, 1, 6, 30, 0, 0, time.UTC),
+		"logs__TRA__2020-05-01_06_30_15": time.Date(2020, 5, 1, 6, 30, 15, 0, time.UTC),
+	}
+
+	for name, start := range tests {
+		if got := TimeRoutedCollectionName("logs", start); got != name {
+			t.Errorf("failed to name collection starting at %v %s", start, got)
+		}
+
+		parsed, err := ParseTimeRoutedCollection("logs", name)
+		if err != nil {
+			t.Fatalf("failed to parse collection name %v", err)
+		}
+		if !parsed.Equal(start) {
+			t.Errorf("failed to parse collection name %s %v", name, parsed)
+		}
+	}
+
+	if _, err := ParseTimeRoutedCollection("logs", "events__TRA__2020-05-01"); err == nil {
+		t.Error("failed to reject a collection of another alias")
+	}
+}
+
+func TestTimeRoutedCollection(t *testing.T) {
+	collections := []string{"logs__TRA__2020-05-03", "logs__TRA__2020-05-01", "logs__TRA__2020-05-02", "events__TRA__2020-05-04"}
+
+	tests := []struct {
+		interval   string
+		t          time.Time
+		collection string
+	}{
+		{"+1DAY", time.Date(2020, 5, 2, 13, 0, 0, 0, time.UTC), "logs__TRA__2020-05-02"},
+		{"+1DAY", time.Date(2020, 5, 3, 23, 59, 59, 0, time.UTC), "logs__TRA__2020-05-03"},
+		{"+1DAY", time.Date(2020, 5, 4, 0, 0, 0, 0, time.UTC), "logs__TRA__2020-05-04"},
+		{"+1DAY", time.Date(2020, 5, 4, 23, 0, 0, 0, time.UTC), "logs__TRA__2020-05-04"},
+		{"+12HOURS", time.Date(2020, 5, 3, 18, 0, 0, 0, time.UTC), "logs__TRA__2020-05-03_12"},
+		{"+1MONTH", time.Date(2020, 5, 20, 0, 0, 0, 0, time.UTC), "logs__TRA__2020-05-03"},
+	}
+
+	for _, test := range tests {
+		collection, err := TimeRoutedCollection("logs", collections, test.interval, test.t)
+		if err != nil {
+			t.Fatalf("failed to route %v with interval %s %v", test.t, test.interval, err)
+		}
+		if collection != test.collection {
+			t.Errorf("failed to route %v with interval %s to %s, routed to %s", test.t, test.interval, test.collection, collection)
+		}
+	}
+
+	if _, err := TimeRoutedCollection("logs", collections, "+1DAY", time.Date(2020, 4, 30, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("failed to reject a timestamp before the first collection")
+	}
+	if _, err := TimeRoutedCollection("logs", collections, "+1DAY", time.Date(2020, 5, 5, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("failed to reject a timestamp more than one interval after the last collection")
+	}
+	if _, err := TimeRoutedCollection("logs", collections, "+1MINUTE", time.Date(2030, 5, 5, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("failed to reject a far future timestamp")
+	}
+	for _, interval := range []string{"", "1DAY", "+1FORTNIGHT", "-1DAY", "/DAY"} {
+		if _, err := TimeRoutedCollection("logs", collections, interval, time.Date(2020, 5, 3, 12, 0, 0, 0, time.UTC)); err == nil {
+			t.Errorf("failed to reject the interval %q", interval)
+		}
+	}
+}
+
+func TestCategoryRoutedCollection(t *testing.T) {
+	if collection := CategoryRoutedCollection("products", " home & garden "); collection != "products__CRA__home___garden" {
+		t.Errorf("failed to route category %s", collection)
+	}
+}
@@ -0,0 +1,472 @@
+// https://lucene.apache.org/solr/guide/8_5/schema-api.html
+package solr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type schemaBase struct {
+	WT WT `url:"wt,omitempty"`
+}
+
+type SchemaParameters struct {
+	schemaBase
+
+	// Comma-separated list of fields to return, all fields are returned by default.
+	Fields []string `url:"fl,comma,omitempty"`
+
+	// If true, all default field properties from each field’s field type will be included in
+	// the response. The default is false.
+	ShowDefaults bool `url:"showDefaults,omitempty"`
+
+	// If true, and if the fl query parameter is specified or the wildcard is used, matching
+	// dynamic fields are included in the response. The default is false.
+	IncludeDynamic bool `url:"includeDynamic,omitempty"`
+}
+
+type CopyFieldsParameters struct {
+	schemaBase
+
+	// Comma-separated list of source fields to include in the response.
+	SourceFields []string `url:"source.fl,comma,omitempty"`
+
+	// Comma-separated list of destination fields to include in the response.
+	DestFields []string `url:"dest.fl,comma,omitempty"`
+}
+
+type SchemaAPI struct {
+	client *Client
+}
+
+type FieldType struct {
+	Name                      string     `json:"name,omitempty"`
+	Class                     string     `json:"class,omitempty"`
+	PositionIncrementGap      FlexString `json:"positionIncrementGap,omitempty"`
+	AutoGeneratePhraseQueries FlexString `json:"autoGeneratePhraseQueries,omitempty"`
+	Indexed                   *bool      `json:"indexed,omitempty"`
+	Stored                    *bool      `json:"stored,omitempty"`
+	DocValues                 *bool      `json:"docValues,omitempty"`
+	MultiValued               *bool      `json:"multiValued,omitempty"`
+	SortMissingFirst          *bool      `json:"sortMissingFirst,omitempty"`
+	SortMissingLast           *bool      `json:"sortMissingLast,omitempty"`
+	OmitNorms                 *bool      `json:"omitNorms,omitempty"`
+	OmitTermFreqAndPositions  *bool      `json:"omitTermFreqAndPositions,omitempty"`
+	OmitPositions             *bool      `json:"omitPositions,omitempty"`
+	Analyzer                  *Analyzer  `json:"analyzer,omitempty"`
+	IndexAnalyzer             *Analyzer  `json:"indexAnalyzer,omitempty"`
+	QueryAnalyzer             *Analyzer  `json:"queryAnalyzer,omitempty"`
+	MultiTermAnalyzer         *Analyzer  `json:"multiTermAnalyzer,omitempty"`
+	Similarity                *Factory   `json:"similarity,omitempty"`
+
+	// Any other attribute of the field type class, e.g. subFieldSuffix, dimension or geo.
+	Attributes map[string]interface{} `json:"-"`
+}
+
+type Analyzer struct {
+	// An analyzer class, used instead of a tokenizer and filters.
+	Class string `json:"class,omitempty"`
+
+	CharFilters []Factory `json:"charFilters,omitempty"`
+	Tokenizer   *Factory  `json:"tokenizer,omitempty"`
+	Filters     []Factory `json:"filters,omitempty"`
+}
+
+// A tokenizer, char filter, token filter or similarity and its arguments.
+type Factory struct {
+	// The factory class, e.g. solr.StandardTokenizerFactory.
+	Class string `json:"class,omitempty"`
+
+	// The SPI name of the factory, e.g. standard, used instead of the class.
+	Name string `json:"name,omitempty"`
+
+	// The arguments of the factory, e.g. ignoreCase or synonyms.
+	Attributes map[string]interface{} `json:"-"`
+}
+
+// A value Solr writes either as a JSON string, number or boolean, e.g. positionIncrementGap
+// is a number with showDefaults=true and a string otherwise. It is kept as a string.
+type FlexString string
+
+type CopyField struct {
+	Source   string `json:"source"`
+	Dest     string `json:"dest"`
+	MaxChars int    `json:"maxChars,omitempty"`
+}
+
+func (f FieldType) MarshalJSON() ([]byte, error) {
+	type fieldType FieldType
+
+	return marshalWithAttributes(fieldType(f), stringAttributes(f.Attributes))
+}
+
+func (f *FieldType) UnmarshalJSON(b []byte) error {
+	type fieldType FieldType
+
+	if err := unmarshalWithAttributes(b, (*fieldType)(f), &f.Attributes); err != nil {
+		return err
+	}
+	f.Attributes = stringAttributes(f.Attributes)
+
+	return nil
+}
+
+func (f Factory) MarshalJSON() ([]byte, error) {
+	type factory Factory
+
+	return marshalWithAttributes(factory(f), stringAttributes(f.Attributes))
+}
+
+func (f *Factory) UnmarshalJSON(b []byte) error {
+	type factory Factory
+
+	if err := unmarshalWithAttributes(b, (*factory)(f), &f.Attributes); err != nil {
+		return err
+	}
+	f.Attributes = stringAttributes(f.Attributes)
+
+	return nil
+}
+
+func (f *FlexString) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	if value, ok := scalarString(v); ok {
+		*f = FlexString(value)
+		return nil
+	}
+
+	switch v.(type) {
+	case nil:
+		*f = ""
+	default:
+		return fmt.Errorf("solr: invalid string %s", b)
+	}
+
+	return nil
+}
+
+// stringAttributes returns the attributes with their numbers and booleans written as strings,
+// the way Solr returns the arguments of factories and field type classes, e.g. ignoreCase or
+// maxDistErr. Other values are kept as is.
+func stringAttributes(attributes map[string]interface{}) map[string]interface{} {
+	if attributes == nil {
+		return nil
+	}
+
+	converted := make(map[string]interface{}, len(attributes))
+	for key, value := range attributes {
+		if s, ok := scalarString(value); ok {
+			converted[key] = s
+			continue
+		}
+		converted[key] = value
+	}
+
+	return converted
+}
+
+// scalarString returns a string, number or boolean as a string, ok is false for other values.
+func scalarString(v interface{}) (s string, ok bool) {
+	switch value := v.(type) {
+	case string:
+		return value, true
+	case bool:
+		return strconv.FormatBool(value), true
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(value), true
+	case int64:
+		return strconv.FormatInt(value, 10), true
+	}
+
+	return "", false
+}
+
+// marshalWithAttributes encodes the struct v merged with the attributes that have no field of their own.
+func marshalWithAttributes(v interface{}, attributes map[string]interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(attributes) == 0 {
+		return b, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	merged := make(map[string]interface{}, len(attributes)+len(fields))
+	for key, value := range attributes {
+		merged[key] = value
+	}
+	for key, value := range fields {
+		merged[key] = value
+	}
+
+	return json.Marshal(merged)
+}
+
+// unmarshalWithAttributes decodes b into the struct pointed by v, keeping the keys that have no
+// field of their own in attributes.
+func unmarshalWithAttributes(b []byte, v interface{}, attributes *map[string]interface{}) error {
+	if err := json.Unmarshal(b, v); err != nil {
+		return err
+	}
+
+	var all map[string]interface{}
+	if err := json.Unmarshal(b, &all); err != nil {
+		return err
+	}
+
+	t := reflect.TypeOf(v).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		delete(all, name)
+	}
+
+	*attributes = nil
+	if len(all) > 0 {
+		*attributes = all
+	}
+
+	return nil
+}
+
+// GET: Retrieve the entire schema
+// The schema is returned in Response.Schema.
+func (s *SchemaAPI) Get(ctx context.Context, collection string) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/schema", collection)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil, schemaBase{WT: JSON}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// FIELDS: List fields
+// The fields are returned in Response.Fields.
+func (s *SchemaAPI) Fields(ctx context.Context, collection string, params SchemaParameters) (*Response, error) {
+	params.WT = JSON
+
+	path := fmt.Sprintf("/solr/%s/schema/fields", collection)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// FIELD: Retrieve a field
+// The field is returned in Response.Field.
+func (s *SchemaAPI) Field(ctx context.Context, collection string, name string, params SchemaParameters) (*Response, error) {
+	params.WT = JSON
+
+	path := fmt.Sprintf("/solr/%s/schema/fields/%s", collection, name)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// DYNAMIC FIELDS: List dynamic fields
+// The dynamic fields are returned in Response.DynamicFields.
+func (s *SchemaAPI) DynamicFields(ctx context.Context, collection string, params SchemaParameters) (*Response, error) {
+	params.WT = JSON
+
+	path := fmt.Sprintf("/solr/%s/schema/dynamicfields", collection)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// DYNAMIC FIELD: Retrieve a dynamic field by its name pattern, e.g. *_s
+// The dynamic field is returned in Response.DynamicField.
+func (s *SchemaAPI) DynamicField(ctx context.Context, collection string, name string, params SchemaParameters) (*Response, error) {
+	params.WT = JSON
+
+	path := fmt.Sprintf("/solr/%s/schema/dynamicfields/%s", collection, name)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// FIELD TYPES: List field types
+// The field types are returned in Response.FieldTypes.
+func (s *SchemaAPI) FieldTypes(ctx context.Context, collection string, params SchemaParameters) (*Response, error) {
+	params.WT = JSON
+
+	path := fmt.Sprintf("/solr/%s/schema/fieldtypes", collection)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// FIELD TYPE: Retrieve a field type
+// The field type is returned in Response.FieldType.
+func (s *SchemaAPI) FieldType(ctx context.Context, collection string, name string, params SchemaParameters) (*Response, error) {
+	params.WT = JSON
+
+	path := fmt.Sprintf("/solr/%s/schema/fieldtypes/%s", collection, name)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// COPY FIELDS: List copy fields
+// The copy fields are returned in Response.CopyFields.
+func (s *SchemaAPI) CopyFields(ctx context.Context, collection string, params CopyFieldsParameters) (*Response, error) {
+	params.WT = JSON
+
+	path := fmt.Sprintf("/solr/%s/schema/copyfields", collection)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// UNIQUE KEY: Show the unique key field
+// The unique key is returned in Response.UniqueKey.
+func (s *SchemaAPI) UniqueKey(ctx context.Context, collection string) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/schema/uniquekey", collection)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil, schemaBase{WT: JSON}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// SIMILARITY: Show the global similarity
+// The similarity is returned in Response.Similarity.
+func (s *SchemaAPI) Similarity(ctx context.Context, collection string) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/schema/similarity", collection)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil, schemaBase{WT: JSON}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// VERSION: Show the schema version
+// The version is returned in Response.Version.
+func (s *SchemaAPI) Version(ctx context.Context, collection string) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/schema/version", collection)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil, schemaBase{WT: JSON}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// NAME: Show the schema name
+// The name is returned in Response.Name.
+func (s *SchemaAPI) Name(ctx context.Context, collection string) (*Response, error) {
+	path := fmt.Sprintf("/solr/%s/schema/name", collection)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil, schemaBase{WT: JSON}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
@@ -0,0 +1,165 @@
+// https://lucene.apache.org/solr/guide/8_5/schema-api.html#modify-the-schema
+package solr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	AddFieldCommand            = "add-field"
+	DeleteFieldCommand         = "delete-field"
+	ReplaceFieldCommand        = "replace-field"
+	AddDynamicFieldCommand     = "add-dynamic-field"
+	DeleteDynamicFieldCommand  = "delete-dynamic-field"
+	ReplaceDynamicFieldCommand = "replace-dynamic-field"
+	AddFieldTypeCommand        = "add-field-type"
+	DeleteFieldTypeCommand     = "delete-field-type"
+	ReplaceFieldTypeCommand    = "replace-field-type"
+	AddCopyFieldCommand        = "add-copy-field"
+	DeleteCopyFieldCommand     = "delete-copy-field"
+)
+
+type SchemaUpdateParameters struct {
+	schemaBase
+
+	// Number of seconds to wait for all replicas to apply the schema changes, no wait is done
+	// by default.
+	UpdateTimeoutSecs int `url:"updateTimeoutSecs,omitempty"`
+}
+
+// Schema API commands, sent in a single request and applied in the order they were added.
+// Either all the commands succeed or none of them is applied.
+type SchemaCommands struct {
+	commands commandList
+}
+
+// A failed command of a multi-command request, reported in Error.Details.
+type CommandError struct {
+	// The name of the command, e.g. add-field.
+	Command string
+
+	// The body of the command as sent.
+	Body json.RawMessage
+
+	ErrorMessages []string
+}
+
+type nameCommand struct {
+	Name string `json:"name"`
+}
+
+// AddField adds a new field to the schema.
+func (s *SchemaCommands) AddField(field Field) *SchemaCommands {
+	return s.add(AddFieldCommand, field)
+}
+
+// DeleteField removes a field from the schema.
+func (s *SchemaCommands) DeleteField(name string) *SchemaCommands {
+	return s.add(DeleteFieldCommand, nameCommand{Name: name})
+}
+
+// ReplaceField replaces a field’s definition, the field must already exist.
+func (s *SchemaCommands) ReplaceField(field Field) *SchemaCommands {
+	return s.add(ReplaceFieldCommand, field)
+}
+
+// AddDynamicField adds a new dynamic field rule to the schema.
+func (s *SchemaCommands) AddDynamicField(field Field) *SchemaCommands {
+	return s.add(AddDynamicFieldCommand, field)
+}
+
+// DeleteDynamicField deletes a dynamic field rule from the schema.
+func (s *SchemaCommands) DeleteDynamicField(name string) *SchemaCommands {
+	return s.add(DeleteDynamicFieldCommand, nameCommand{Name: name})
+}
+
+// ReplaceDynamicField replaces a dynamic field rule, the rule must already exist.
+func (s *SchemaCommands) ReplaceDynamicField(field Field) *SchemaCommands {
+	return s.add(ReplaceDynamicFieldCommand, field)
+}
+
+// AddFieldType adds a new field type to the schema.
+func (s *SchemaCommands) AddFieldType(fieldType FieldType) *SchemaCommands {
+	return s.add(AddFieldTypeCommand, fieldType)
+}
+
+// DeleteFieldType removes a field type from the schema, it must not be used by any field.
+func (s *SchemaCommands) DeleteFieldType(name string) *SchemaCommands {
+	return s.add(DeleteFieldTypeCommand, nameCommand{Name: name})
+}
+
+// ReplaceFieldType replaces a field type, the field type must already exist.
+func (s *SchemaCommands) ReplaceFieldType(fieldType FieldType) *SchemaCommands {
+	return s.add(ReplaceFieldTypeCommand, fieldType)
+}
+
+// AddCopyField adds a new copy field rule to the schema.
+func (s *SchemaCommands) AddCopyField(copyField CopyField) *SchemaCommands {
+	return s.add(AddCopyFieldCommand, copyField)
+}
+
+// DeleteCopyField deletes a copy field rule from the schema.
+func (s *SchemaCommands) DeleteCopyField(source string, dest string) *SchemaCommands {
+	return s.add(DeleteCopyFieldCommand, CopyField{Source: source, Dest: dest})
+}
+
+// Len returns the number of commands.
+func (s *SchemaCommands) Len() int {
+	return len(s.commands)
+}
+
+func (s *SchemaCommands) add(name string, body interface{}) *SchemaCommands {
+	s.commands.add(name, body)
+	return s
+}
+
+func (s SchemaCommands) MarshalJSON() ([]byte, error) {
+	return s.commands.MarshalJSON()
+}
+
+func (c *CommandError) UnmarshalJSON(b []byte) error {
+	var detail map[string]json.RawMessage
+	if err := json.Unmarshal(b, &detail); err != nil {
+		return err
+	}
+
+	for key, value := range detail {
+		if key == "errorMessages" {
+			if err := json.Unmarshal(value, &c.ErrorMessages); err != nil {
+				return err
+			}
+			continue
+		}
+
+		c.Command = key
+		c.Body = value
+	}
+
+	return nil
+}
+
+// MODIFY: Apply schema commands in a single atomic request
+// When a command fails no command is applied and the failures are reported in Response.Error.Details.
+func (s *SchemaAPI) Modify(ctx context.Context, collection string, commands SchemaCommands, params *SchemaUpdateParameters) (*Response, error) {
+	if params == nil {
+		params = &SchemaUpdateParameters{}
+	}
+	params.WT = JSON
+
+	path := fmt.Sprintf("/solr/%s/schema", collection)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, commands, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
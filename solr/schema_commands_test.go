@@ -0,0 +1,78 @@
+package solr
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSchemaCommandsMarshal(t *testing.T) {
+	commands := SchemaCommands{}
+	commands.
+		AddField(Field{Name: "title", Type: "text_en", Stored: Bool(true)}).
+		AddField(Field{Name: "sku", Type: "string"}).
+		DeleteField("legacy").
+		AddCopyField(CopyField{Source: "title", Dest: "_text_"}).
+		DeleteCopyField("old", "_text_").
+		ReplaceFieldType(FieldType{Name: "string", Class: "solr.StrField", DocValues: Bool(true)})
+
+	if commands.Len() != 6 {
+		t.Errorf("failed to add schema commands %d", commands.Len())
+	}
+
+	b, err := json.Marshal(commands)
+	if err != nil {
+		t.Fatalf("failed to marshal schema commands %v", err)
+	}
+
+	expected := `{"add-field":{"name":"title","type":"text_en","stored":true},` +
+		`"add-field":{"name":"sku","type":"string"},` +
+		`"delete-field":{"name":"legacy"},` +
+		`"add-copy-field":{"source":"title","dest":"_text_"},` +
+		`"delete-copy-field":{"source":"old","dest":"_text_"},` +
+		`"replace-field-type":{"name":"string","class":"solr.StrField","docValues":true}}`
+	if string(b) != expected {
+		t.Errorf("failed to marshal schema commands %s", b)
+	}
+
+	b, err = json.Marshal(SchemaCommands{})
+	if err != nil {
+		t.Errorf("failed to marshal empty schema commands %v", err)
+	}
+	if string(b) != "{}" {
+		t.Errorf("failed to marshal empty schema commands %s", b)
+	}
+}
+
+func TestSchemaCommandErrorDecode(t *testing.T) {
+	b := []byte(`{
+		"responseHeader": {"status": 400, "QTime": 3},
+		"error": {
+			"metadata": ["error-class", "org.apache.solr.api.ApiBag$ExceptionWithErrObject"],
+			"details": [
+				{"add-field": {"name": "title", "type": "unknown"}, "errorMessages": ["Field 'title': Field type 'unknown' not found.\n"]}
+			],
+			"msg": "error processing commands",
+			"code": 400
+		}
+	}`)
+
+	var response Response
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatalf("failed to decode schema command error %v", err)
+	}
+
+	if len(response.Error.Details) != 1 {
+		t.Fatalf("failed to decode schema command error details %+v", response.Error)
+	}
+
+	detail := response.Error.Details[0]
+	if detail.Command != AddFieldCommand {
+		t.Errorf("failed to decode failed command %q", detail.Command)
+	}
+	if string(detail.Body) != `{"name": "title", "type": "unknown"}` {
+		t.Errorf("failed to decode failed command body %s", detail.Body)
+	}
+	if len(detail.ErrorMessages) != 1 || detail.ErrorMessages[0] != "Field 'title': Field type 'unknown' not found.\n" {
+		t.Errorf("failed to decode failed command messages %q", detail.ErrorMessages)
+	}
+}
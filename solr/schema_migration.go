@@ -0,0 +1,372 @@
+// https://lucene.apache.org/solr/guide/8_5/schema-api.html#modify-the-schema
+package solr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+type SchemaDiffOptions struct {
+	// If true, the fields, dynamic fields, field types and copy fields missing from the desired
+	// schema are deleted. The unique key field, internal fields such as _version_ and the field
+	// types still in use are never deleted. By default elements missing from the desired schema
+	// are left untouched.
+	Prune bool
+}
+
+type MigrationOptions struct {
+	SchemaDiffOptions
+
+	// If true, the plan is computed but not applied.
+	DryRun bool
+
+	// Number of seconds to wait for all replicas to apply the schema changes.
+	UpdateTimeoutSecs int
+}
+
+// The changes bringing a schema to its desired state, in the order they are applied.
+type SchemaPlan struct {
+	Changes []SchemaChange
+}
+
+type SchemaChange struct {
+	// The Schema API command, e.g. AddFieldCommand.
+	Command string
+
+	// The name of the changed element, "<source> -> <dest>" for copy fields.
+	Name string
+
+	body interface{}
+}
+
+// DiffSchema compares the current schema of a collection with the desired one and returns the
+// plan of commands applying the difference. The properties of an element are compared as
+// given, an element is replaced when the desired definition differs from the current one.
+func DiffSchema(current Schema, desired Schema, options SchemaDiffOptions) (*SchemaPlan, error) {
+	plan := &SchemaPlan{}
+
+	fieldTypes, err := diffElements(fieldTypeElements(current.FieldTypes), fieldTypeElements(desired.FieldTypes))
+	if err != nil {
+		return nil, err
+	}
+	fields, err := diffElements(fieldElements(current.Fields), fieldElements(desired.Fields))
+	if err != nil {
+		return nil, err
+	}
+	dynamicFields, err := diffElements(fieldElements(current.DynamicFields), fieldElements(desired.DynamicFields))
+	if err != nil {
+		return nil, err
+	}
+	copyFields, err := diffElements(copyFieldElements(current.CopyFields), copyFieldElements(desired.CopyFields))
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy fields can't be replaced, a changed copy field is deleted and added again. Copy
+	// fields are deleted first as they may reference fields deleted by the plan.
+	for _, element := range copyFields.changed {
+		plan.add(DeleteCopyFieldCommand, element)
+	}
+	if options.Prune {
+		for _, element := range copyFields.removed {
+			plan.add(DeleteCopyFieldCommand, element)
+		}
+	}
+
+	plan.addAll(AddFieldTypeCommand, fieldTypes.added)
+	plan.addAll(ReplaceFieldTypeCommand, fieldTypes.changed)
+	plan.addAll(AddFieldCommand, fields.added)
+	plan.addAll(ReplaceFieldCommand, fields.changed)
+	plan.addAll(AddDynamicFieldCommand, dynamicFields.added)
+	plan.addAll(ReplaceDynamicFieldCommand, dynamicFields.changed)
+	plan.addAll(AddCopyFieldCommand, copyFields.added)
+	plan.addAll(AddCopyFieldCommand, copyFields.changed)
+
+	if options.Prune {
+		// The field types still used by a kept field or dynamic field can't be deleted.
+		used := map[string]bool{}
+		for _, field := range desired.Fields {
+			used[field.Type] = true
+		}
+		for _, field := range desired.DynamicFields {
+			used[field.Type] = true
+		}
+
+		for _, element := range fields.removed {
+			if element.name == current.UniqueKey || isInternalField(element.name) {
+				used[element.value.(Field).Type] = true
+				continue
+			}
+			plan.add(DeleteFieldCommand, element)
+		}
+		plan.addAll(DeleteDynamicFieldCommand, dynamicFields.removed)
+		for _, element := range fieldTypes.removed {
+			if !used[element.name] {
+				plan.add(DeleteFieldTypeCommand, element)
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+// Empty reports whether the schema is already in its desired state.
+func (p *SchemaPlan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Commands returns the Schema API commands applying the plan.
+func (p *SchemaPlan) Commands() SchemaCommands {
+	commands := SchemaCommands{}
+	for _, change := range p.Changes {
+		commands.add(change.Command, change.body)
+	}
+
+	return commands
+}
+
+// String returns the plan with one change per line, prefixed by + for additions, ~ for
+// replacements and - for deletions.
+func (p *SchemaPlan) String() string {
+	var b strings.Builder
+	for _, change := range p.Changes {
+		prefix := "~"
+		switch {
+		case strings.HasPrefix(change.Command, "add-"):
+			prefix = "+"
+		case strings.HasPrefix(change.Command, "delete-"):
+			prefix = "-"
+		}
+		fmt.Fprintf(&b, "%s %s %s\n", prefix, change.Command, change.Name)
+	}
+
+	return b.String()
+}
+
+func (p *SchemaPlan) add(command string, element schemaElement) {
+	body := element.value
+	if strings.HasPrefix(command, "delete-") {
+		body = element.deleteBody
+	}
+
+	p.Changes = append(p.Changes, SchemaChange{Command: command, Name: element.name, body: body})
+}
+
+func (p *SchemaPlan) addAll(command string, elements []schemaElement) {
+	for _, element := range elements {
+		p.add(command, element)
+	}
+}
+
+// LoadSchemaFile reads a desired schema from a JSON or YAML file, using the same property
+// names as the Schema API, e.g. fieldTypes, fields, dynamicFields and copyFields.
+func LoadSchemaFile(path string) (Schema, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Schema{}, err
+	}
+
+	schema := Schema{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = UnmarshalSchemaYAML(b, &schema)
+	default:
+		err = json.Unmarshal(b, &schema)
+	}
+
+	return schema, err
+}
+
+// UnmarshalSchemaYAML decodes a schema written in YAML, using the same property names as
+// the Schema API.
+func UnmarshalSchemaYAML(b []byte, schema *Schema) error {
+	var v interface{}
+	if err := yaml.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	v, err := yamlToJSON(v)
+	if err != nil {
+		return err
+	}
+
+	j, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(j, schema)
+}
+
+// yamlToJSON converts the maps decoded by yaml, keyed by interface{}, to maps keyed by string.
+func yamlToJSON(v interface{}) (interface{}, error) {
+	switch value := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			converted, err := yamlToJSON(item)
+			if err != nil {
+				return nil, err
+			}
+			m[fmt.Sprint(key)] = converted
+		}
+		return m, nil
+	case []interface{}:
+		for i, item := range value {
+			converted, err := yamlToJSON(item)
+			if err != nil {
+				return nil, err
+			}
+			value[i] = converted
+		}
+		return value, nil
+	default:
+		return v, nil
+	}
+}
+
+type schemaElement struct {
+	name       string
+	value      interface{}
+	deleteBody interface{}
+}
+
+type schemaDiff struct {
+	added   []schemaElement
+	changed []schemaElement
+	removed []schemaElement
+}
+
+func fieldTypeElements(fieldTypes []FieldType) []schemaElement {
+	elements := make([]schemaElement, 0, len(fieldTypes))
+	for _, fieldType := range fieldTypes {
+		elements = append(elements, schemaElement{name: fieldType.Name, value: fieldType, deleteBody: nameCommand{Name: fieldType.Name}})
+	}
+
+	return elements
+}
+
+func fieldElements(fields []Field) []schemaElement {
+	elements := make([]schemaElement, 0, len(fields))
+	for _, field := range fields {
+		elements = append(elements, schemaElement{name: field.Name, value: field, deleteBody: nameCommand{Name: field.Name}})
+	}
+
+	return elements
+}
+
+func copyFieldElements(copyFields []CopyField) []schemaElement {
+	elements := make([]schemaElement, 0, len(copyFields))
+	for _, copyField := range copyFields {
+		elements = append(elements, schemaElement{
+			name:       copyField.Source + " -> " + copyField.Dest,
+			value:      copyField,
+			deleteBody: CopyField{Source: copyField.Source, Dest: copyField.Dest},
+		})
+	}
+
+	return elements
+}
+
+// diffElements matches the elements by name, keeping the order of the desired elements for
+// additions and changes and sorting the removed elements by name.
+func diffElements(current []schemaElement, desired []schemaElement) (schemaDiff, error) {
+	diff := schemaDiff{}
+
+	byName := make(map[string]schemaElement, len(current))
+	for _, element := range current {
+		byName[element.name] = element
+	}
+
+	seen := make(map[string]bool, len(desired))
+	for _, element := range desired {
+		seen[element.name] = true
+
+		existing, ok := byName[element.name]
+		if !ok {
+			diff.added = append(diff.added, element)
+			continue
+		}
+
+		equal, err := equalJSON(existing.value, element.value)
+		if err != nil {
+			return diff, err
+		}
+		if !equal {
+			diff.changed = append(diff.changed, element)
+		}
+	}
+
+	for _, element := range current {
+		if !seen[element.name] {
+			diff.removed = append(diff.removed, element)
+		}
+	}
+	sort.SliceStable(diff.removed, func(i, j int) bool {
+		return diff.removed[i].name < diff.removed[j].name
+	})
+
+	return diff, nil
+}
+
+// equalJSON reports whether a and b have the same JSON representation, regardless of the
+// order of their properties.
+func equalJSON(a interface{}, b interface{}) (bool, error) {
+	var values [2]interface{}
+	for i, v := range []interface{}{a, b} {
+		j, err := json.Marshal(v)
+		if err != nil {
+			return false, err
+		}
+		if err := json.Unmarshal(j, &values[i]); err != nil {
+			return false, err
+		}
+	}
+
+	return reflect.DeepEqual(values[0], values[1]), nil
+}
+
+// isInternalField reports whether the field is managed by Solr, e.g. _version_ or _root_.
+func isInternalField(name string) bool {
+	return len(name) > 2 && strings.HasPrefix(name, "_") && strings.HasSuffix(name, "_")
+}
+
+// MIGRATE: Bring the schema of a collection to its desired state
+// The current schema is compared with the desired one and the resulting plan is applied in a
+// single request, unless DryRun is set. Applying the same schema again produces an empty plan
+// and sends no request.
+func (s *SchemaAPI) Migrate(ctx context.Context, collection string, desired Schema, options MigrationOptions) (*SchemaPlan, *Response, error) {
+	response, err := s.Get(ctx, collection)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := response.Err(); err != nil {
+		return nil, response, err
+	}
+
+	plan, err := DiffSchema(response.Schema, desired, options.SchemaDiffOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if options.DryRun || plan.Empty() {
+		return plan, nil, nil
+	}
+
+	response, err = s.Modify(ctx, collection, plan.Commands(), &SchemaUpdateParameters{
+		UpdateTimeoutSecs: options.UpdateTimeoutSecs,
+	})
+	if err != nil {
+		return plan, nil, err
+	}
+
+	return plan, response, nil
+}
@@ -0,0 +1,247 @@
+package solr
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDiffSchema(t *testing.T) {
+	current := Schema{
+		UniqueKey: "id",
+		FieldTypes: []FieldType{
+			{Name: "string", Class: "solr.StrField", SortMissingLast: Bool(true)},
+			{Name: "text_old", Class: "solr.TextField"},
+		},
+		Fields: []Field{
+			{Name: "id", Type: "string", Required: Bool(true)},
+			{Name: "_version_", Type: "plong"},
+			{Name: "title", Type: "string"},
+			{Name: "legacy", Type: "string"},
+		},
+		DynamicFields: []Field{{Name: "*_s", Type: "string"}},
+		CopyFields: []CopyField{
+			{Source: "title", Dest: "_text_", MaxChars: 100},
+			{Source: "legacy", Dest: "_text_"},
+		},
+	}
+
+	desired := Schema{
+		FieldTypes: []FieldType{
+			{Name: "string", Class: "solr.StrField", SortMissingLast: Bool(true)},
+			{Name: "text_en", Class: "solr.TextField", Attributes: map[string]interface{}{"positionIncrementGap": "100"}},
+		},
+		Fields: []Field{
+			{Name: "title", Type: "text_en", Stored: Bool(true)},
+			{Name: "sku", Type: "string"},
+		},
+		DynamicFields: []Field{{Name: "*_s", Type: "string"}},
+		CopyFields:    []CopyField{{Source: "title", Dest: "_text_", MaxChars: 256}},
+	}
+
+	plan, err := DiffSchema(current, desired, SchemaDiffOptions{})
+	if err != nil {
+		t.Fatalf("failed to diff schema %v", err)
+	}
+
+	expected := "- delete-copy-field title -> _text_\n" +
+		"+ add-field-type text_en\n" +
+		"+ add-field sku\n" +
+		"~ replace-field title\n" +
+		"+ add-copy-field title -> _text_\n"
+	if plan.String() != expected {
+		t.Errorf("failed to plan schema changes\n%s", plan)
+	}
+
+	plan, err = DiffSchema(current, desired, SchemaDiffOptions{Prune: true})
+	if err != nil {
+		t.Fatalf("failed to diff schema with prune %v", err)
+	}
+
+	expected = "- delete-copy-field title -> _text_\n" +
+		"- delete-copy-field legacy -> _text_\n" +
+		"+ add-field-type text_en\n" +
+		"+ add-field sku\n" +
+		"~ replace-field title\n" +
+		"+ add-copy-field title -> _text_\n" +
+		"- delete-field legacy\n" +
+		"- delete-field-type text_old\n"
+	if plan.String() != expected {
+		t.Errorf("failed to plan schema changes\n%s", plan)
+	}
+
+	b, err := json.Marshal(plan.Commands())
+	if err != nil {
+		t.Fatalf("failed to marshal schema plan %v", err)
+	}
+
+	commands := `{"delete-copy-field":{"source":"title","dest":"_text_"},` +
+		`"delete-copy-field":{"source":"legacy","dest":"_text_"},` +
+		`"add-field-type":{"class":"solr.TextField","name":"text_en","positionIncrementGap":"100"},` +
+		`"add-field":{"name":"sku","type":"string"},` +
+		`"replace-field":{"name":"title","type":"text_en","stored":true},` +
+		`"add-copy-field":{"source":"title","dest":"_text_","maxChars":256},` +
+		`"delete-field":{"name":"legacy"},` +
+		`"delete-field-type":{"name":"text_old"}}`
+	if string(b) != commands {
+		t.Errorf("failed to marshal schema plan %s", b)
+	}
+}
+
+func TestDiffSchemaIdempotent(t *testing.T) {
+	schema := Schema{
+		FieldTypes: []FieldType{{Name: "string", Class: "solr.StrField", DocValues: Bool(true)}},
+		Fields:     []Field{{Name: "title", Type: "string", Stored: Bool(true)}},
+		CopyFields: []CopyField{{Source: "title", Dest: "_text_"}},
+	}
+
+	plan, err := DiffSchema(schema, schema, SchemaDiffOptions{Prune: true})
+	if err != nil {
+		t.Fatalf("failed to diff schema %v", err)
+	}
+
+	if !plan.Empty() {
+		t.Errorf("failed to diff an unchanged schema\n%s", plan)
+	}
+}
+
+func TestDiffSchemaSolrOutput(t *testing.T) {
+	// The schema as returned by Solr, with the arguments of the factories written as strings.
+	b := []byte(`{
+		"uniqueKey": "id",
+		"fieldTypes": [{
+			"name": "text_en", "class": "solr.TextField", "positionIncrementGap": "100",
+			"analyzer": {
+				"tokenizer": {"class": "solr.StandardTokenizerFactory"},
+				"filters": [{"class": "solr.StopFilterFactory", "ignoreCase": "true", "words": "stopwords.txt"}]
+			}
+		}, {
+			"name": "location", "class": "solr.LatLonPointSpatialField", "docValues": true
+		}],
+		"fields": [{"name": "title", "type": "text_en", "stored": true}]
+	}`)
+
+	var current Schema
+	if err := json.Unmarshal(b, &current); err != nil {
+		t.Fatalf("failed to decode schema %v", err)
+	}
+
+	var desired Schema
+	err := UnmarshalSchemaYAML([]byte(`
+fieldTypes:
+  - name: text_en
+    class: solr.TextField
+    positionIncrementGap: 100
+    analyzer:
+      tokenizer:
+        class: solr.StandardTokenizerFactory
+      filters:
+        - class: solr.StopFilterFactory
+          ignoreCase: true
+          words: stopwords.txt
+  - name: location
+    class: solr.LatLonPointSpatialField
+    docValues: true
+fields:
+  - name: title
+    type: text_en
+    stored: true
+`), &desired)
+	if err != nil {
+		t.Fatalf("failed to decode desired schema %v", err)
+	}
+
+	plan, err := DiffSchema(current, desired, SchemaDiffOptions{Prune: true})
+	if err != nil {
+		t.Fatalf("failed to diff schema %v", err)
+	}
+
+	if !plan.Empty() {
+		t.Errorf("failed to match the schema returned by Solr\n%s", plan)
+	}
+}
+
+func TestDiffSchemaPruneUsedFieldTypes(t *testing.T) {
+	current := Schema{
+		UniqueKey: "id",
+		FieldTypes: []FieldType{
+			{Name: "string", Class: "solr.StrField"},
+			{Name: "plong", Class: "solr.LongPointField"},
+			{Name: "pdate", Class: "solr.DatePointField"},
+			{Name: "text_old", Class: "solr.TextField"},
+		},
+		Fields: []Field{
+			{Name: "id", Type: "string"},
+			{Name: "_version_", Type: "plong"},
+			{Name: "legacy", Type: "text_old"},
+		},
+		DynamicFields: []Field{{Name: "*_dt", Type: "pdate"}},
+	}
+
+	desired := Schema{
+		DynamicFields: []Field{{Name: "*_dt", Type: "pdate"}},
+	}
+
+	plan, err := DiffSchema(current, desired, SchemaDiffOptions{Prune: true})
+	if err != nil {
+		t.Fatalf("failed to diff schema %v", err)
+	}
+
+	expected := "- delete-field legacy\n" +
+		"- delete-field-type text_old\n"
+	if plan.String() != expected {
+		t.Errorf("failed to keep the field types in use\n%s", plan)
+	}
+}
+
+func TestLoadSchemaFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "schema")
+	if err != nil {
+		t.Fatalf("failed to create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "schema.yaml")
+	err = ioutil.WriteFile(path, []byte(`
+fieldTypes:
+  - name: text_en
+    class: solr.TextField
+    positionIncrementGap: "100"
+    analyzer:
+      tokenizer:
+        class: solr.StandardTokenizerFactory
+      filters:
+        - class: solr.StopFilterFactory
+          ignoreCase: true
+fields:
+  - name: title
+    type: text_en
+    stored: true
+copyFields:
+  - source: title
+    dest: _text_
+`), 0644)
+	if err != nil {
+		t.Fatalf("failed to write schema file %v", err)
+	}
+
+	schema, err := LoadSchemaFile(path)
+	if err != nil {
+		t.Fatalf("failed to load schema file %v", err)
+	}
+
+	if len(schema.FieldTypes) != 1 || schema.FieldTypes[0].Analyzer == nil || len(schema.FieldTypes[0].Analyzer.Filters) != 1 {
+		t.Fatalf("failed to load field types %+v", schema.FieldTypes)
+	}
+	if schema.FieldTypes[0].Analyzer.Filters[0].Attributes["ignoreCase"] != "true" {
+		t.Errorf("failed to load filter %+v", schema.FieldTypes[0].Analyzer.Filters[0])
+	}
+	if len(schema.Fields) != 1 || schema.Fields[0].Stored == nil || !*schema.Fields[0].Stored {
+		t.Errorf("failed to load fields %+v", schema.Fields)
+	}
+	if len(schema.CopyFields) != 1 || schema.CopyFields[0].Dest != "_text_" {
+		t.Errorf("failed to load copy fields %+v", schema.CopyFields)
+	}
+}
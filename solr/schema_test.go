@@ -0,0 +1,119 @@
+package solr
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSchemaResponseDecode(t *testing.T) {
+	b := []byte(`{
+		"responseHeader": {"status": 0, "QTime": 1},
+		"schema": {
+			"name": "default-config",
+			"version": 1.6,
+			"uniqueKey": "id",
+			"similarity": {"class": "org.apache.solr.search.similarities.SchemaSimilarityFactory"},
+			"fieldTypes": [
+				{"name": "string", "class": "solr.StrField", "sortMissingLast": true, "docValues": true},
+				{
+					"name": "text_en", "class": "solr.TextField", "positionIncrementGap": "100",
+					"indexAnalyzer": {
+						"tokenizer": {"class": "solr.StandardTokenizerFactory"},
+						"filters": [
+							{"class": "solr.StopFilterFactory", "words": "lang/stopwords_en.txt", "ignoreCase": "true"},
+							{"class": "solr.PorterStemFilterFactory"}
+						]
+					},
+					"queryAnalyzer": {"tokenizer": {"name": "standard"}}
+				},
+				{"name": "location_rpt", "class": "solr.SpatialRecursivePrefixTreeFieldType", "geo": "true", "maxDistErr": "0.001"}
+			],
+			"fields": [
+				{"name": "id", "type": "string", "multiValued": false, "indexed": true, "required": true, "stored": true},
+				{"name": "_version_", "type": "plong", "indexed": false, "stored": false, "docValues": true}
+			],
+			"dynamicFields": [{"name": "*_s", "type": "string", "indexed": true, "stored": true}],
+			"copyFields": [{"source": "title", "dest": "_text_", "maxChars": 256}]
+		}
+	}`)
+
+	var response Response
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatalf("failed to decode schema response %v", err)
+	}
+
+	schema := response.Schema
+	if schema.Name != "default-config" || schema.UniqueKey != "id" || schema.Similarity.Class != "org.apache.solr.search.similarities.SchemaSimilarityFactory" {
+		t.Errorf("failed to decode schema %v", schema)
+	}
+
+	if len(schema.FieldTypes) != 3 || !*schema.FieldTypes[0].DocValues || schema.FieldTypes[1].PositionIncrementGap != "100" {
+		t.Fatalf("failed to decode field types %v", schema.FieldTypes)
+	}
+
+	stop := schema.FieldTypes[1].IndexAnalyzer.Filters[0]
+	if stop.Class != "solr.StopFilterFactory" || stop.Attributes["words"] != "lang/stopwords_en.txt" || stop.Attributes["ignoreCase"] != "true" {
+		t.Errorf("failed to decode filter %v", stop)
+	}
+
+	if schema.FieldTypes[1].QueryAnalyzer.Tokenizer.Name != "standard" || schema.FieldTypes[2].Attributes["geo"] != "true" {
+		t.Errorf("failed to decode field types %v", schema.FieldTypes)
+	}
+
+	version := schema.Fields[1]
+	if *version.Indexed || *version.Stored || !*version.DocValues || version.Required != nil {
+		t.Errorf("failed to decode field %v", version)
+	}
+
+	if schema.CopyFields[0] != (CopyField{Source: "title", Dest: "_text_", MaxChars: 256}) || schema.DynamicFields[0].Name != "*_s" {
+		t.Errorf("failed to decode copy fields %v or dynamic fields %v", schema.CopyFields, schema.DynamicFields)
+	}
+}
+
+func TestSchemaElementsDecode(t *testing.T) {
+	var response Response
+	err := json.Unmarshal([]byte(`{"responseHeader": {"status": 0}, "uniqueKey": "id", "version": 1.6, "field": {"name": "title", "type": "text_en", "default": "none"}}`), &response)
+	if err != nil {
+		t.Fatalf("failed to decode schema elements %v", err)
+	}
+
+	if response.UniqueKey != "id" || response.Version != 1.6 || response.Field.Default != "none" {
+		t.Errorf("failed to decode schema elements %v %v %v", response.UniqueKey, response.Version, response.Field)
+	}
+}
+
+func TestFieldTypeEncode(t *testing.T) {
+	b, err := json.Marshal(FieldType{
+		Name:  "location_rpt",
+		Class: "solr.SpatialRecursivePrefixTreeFieldType",
+		Attributes: map[string]interface{}{
+			"geo": "true",
+		},
+		Analyzer: &Analyzer{
+			Tokenizer: &Factory{Class: "solr.KeywordTokenizerFactory"},
+			Filters:   []Factory{{Name: "lowercase", Attributes: map[string]interface{}{"preserveOriginal": "true"}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to encode field type %v", err)
+	}
+
+	expected := `{"analyzer":{"tokenizer":{"class":"solr.KeywordTokenizerFactory"},"filters":[{"name":"lowercase","preserveOriginal":"true"}]},` +
+		`"class":"solr.SpatialRecursivePrefixTreeFieldType","geo":"true","name":"location_rpt"}`
+	if string(b) != expected {
+		t.Errorf("failed to encode field type %v", string(b))
+	}
+}
+
+func TestFieldTypeShowDefaultsDecode(t *testing.T) {
+	b := []byte(`{"name": "text_en", "class": "solr.TextField", "positionIncrementGap": 100, "autoGeneratePhraseQueries": false, "indexed": true}`)
+
+	var fieldType FieldType
+	if err := json.Unmarshal(b, &fieldType); err != nil {
+		t.Fatalf("failed to decode field type %v", err)
+	}
+
+	if fieldType.PositionIncrementGap != "100" || fieldType.AutoGeneratePhraseQueries != "false" || !*fieldType.Indexed {
+		t.Errorf("failed to decode field type defaults %v", fieldType)
+	}
+}
@@ -0,0 +1,181 @@
+// https://lucene.apache.org/solr/guide/8_5/shard-management.html
+package solr
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+type SplitMethod string
+
+const (
+	// Rewrites the index of the sub-shards, slower but producing compact indexes. This is the default.
+	RewriteSplit SplitMethod = "rewrite"
+
+	// Hard-links the index files of the parent shard, faster but the sub-shards need to be optimized
+	// to reclaim their space.
+	LinkSplit SplitMethod = "link"
+)
+
+// The core properties of new replicas, sent as property.<name>=<value>.
+type CoreProperties map[string]string
+
+type CollectionSplitShard struct {
+	collectionBase
+
+	// The name of the collection that includes the shard to be split. This parameter is required.
+	Collection string `url:"collection,omitempty"`
+
+	// The name of the shard to be split. This parameter is required when split.key is not specified.
+	Shard string `url:"shard,omitempty"`
+
+	// The hash ranges of the sub-shards, which must cover the range of the parent shard. By default
+	// the range of the parent shard is divided equally.
+	Ranges []HashRange `url:"ranges,comma,omitempty"`
+
+	// The key to use for splitting the index, the shard holding the route key is split so that the
+	// documents of the key end up in a sub-shard of their own.
+	SplitKey string `url:"split.key,omitempty"`
+
+	// The number of sub-shards to split the parent shard into, between 2 and 8. The default is 2.
+	// This parameter can't be used with ranges or split.key.
+	NumSubShards int `url:"numSubShards,omitempty"`
+
+	// The method used to split the index, RewriteSplit or LinkSplit.
+	SplitMethod SplitMethod `url:"splitMethod,omitempty"`
+
+	// A float value between 0 and 0.5 varying the size of the sub-shard ranges by that fraction, to
+	// spread out the time the sub-shards will be split again.
+	SplitFuzz float64 `url:"splitFuzz,omitempty"`
+
+	// If true, the split point is selected by the distribution of the route key prefixes in the
+	// shard, so that documents with the same prefix stay on the same sub-shard.
+	SplitByPrefix bool `url:"splitByPrefix,omitempty"`
+
+	// The core properties of the sub-shard replicas, sent as property.<name>=<value>.
+	Properties CoreProperties `url:"property,omitempty"`
+
+	// If true, the request will complete only when all affected replicas become active.
+	WaitForFinalState bool `url:"waitForFinalState,omitempty"`
+
+	// If true, the response includes the time taken by each phase of the split.
+	Timing bool `url:"timing,omitempty"`
+
+	// Request ID to track this action which will be processed asynchronously.
+	Async string `url:"async,omitempty"`
+}
+
+type CollectionCreateShard struct {
+	collectionBase
+
+	// The name of the collection that includes the shard to be created. The collection must use
+	// the implicit router. This parameter is required.
+	Collection string `url:"collection,omitempty"`
+
+	// The name of the shard to be created. This parameter is required.
+	Shard string `url:"shard,omitempty"`
+
+	// The nodes to create the replicas of the shard on, as a comma-separated list, e.g.
+	// localhost:8983_solr. By default the replicas are spread across all live nodes.
+	CreateNodeSet []string `url:"createNodeSet,comma,omitempty"`
+
+	// The number of replicas of each type to create, the collection settings are used by default.
+	NrtReplicas       int `url:"nrtReplicas,omitempty"`
+	TLogReplicas      int `url:"tlogReplicas,omitempty"`
+	PullReplicas      int `url:"pullReplicas,omitempty"`
+	ReplicationFactor int `url:"replicationFactor,omitempty"`
+
+	// The core properties of the replicas, sent as property.<name>=<value>.
+	Properties CoreProperties `url:"property,omitempty"`
+
+	// If true, the request will complete only when all affected replicas become active.
+	WaitForFinalState bool `url:"waitForFinalState,omitempty"`
+
+	// Request ID to track this action which will be processed asynchronously.
+	Async string `url:"async,omitempty"`
+}
+
+type CollectionDeleteShard struct {
+	collectionBase
+
+	// The name of the collection that includes the shard to be deleted. This parameter is required.
+	Collection string `url:"collection,omitempty"`
+
+	// The name of the shard to be deleted, which must be inactive unless the collection uses the
+	// implicit router. This parameter is required.
+	Shard string `url:"shard,omitempty"`
+
+	// By default Solr deletes the instance directory, the data directory and the index of each
+	// replica, set the matching option to false to keep them.
+	DeleteInstanceDir *bool `url:"deleteInstanceDir,omitempty"`
+	DeleteDataDir     *bool `url:"deleteDataDir,omitempty"`
+	DeleteIndex       *bool `url:"deleteIndex,omitempty"`
+
+	// Request ID to track this action which will be processed asynchronously.
+	Async string `url:"async,omitempty"`
+}
+
+// EncodeValues encodes the properties as property.<name>=<value>, sorted by name.
+func (p CoreProperties) EncodeValues(key string, v *url.Values) error {
+	return AliasProperties(p).EncodeValues(key, v)
+}
+
+// SplitShard: Split a Shard
+// Splits an active shard into sub-shards. The parent shard is left inactive once the sub-shards
+// are active and can be removed with DeleteShard.
+func (c *CollectionAPI) SplitShard(ctx context.Context, collection CollectionSplitShard) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = SplitShardAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// CreateShard: Create a Shard
+// Creates a new shard in a collection using the implicit router.
+func (c *CollectionAPI) CreateShard(ctx context.Context, collection CollectionCreateShard) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = CreateShardAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
+// DeleteShard: Delete a Shard
+// Deletes an inactive shard, e.g. the parent of a split shard, or any shard of a collection using
+// the implicit router.
+func (c *CollectionAPI) DeleteShard(ctx context.Context, collection CollectionDeleteShard) (*Response, error) {
+	collection.WT = JSON
+	collection.Action = DeleteShardAction
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, "/solr/admin/collections", nil, collection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
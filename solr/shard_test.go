@@ -0,0 +1,80 @@
+package solr
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestSplitShardParameters(t *testing.T) {
+	first, err := ParseHashRange("80000000-bfffffff")
+	if err != nil {
+		t.Fatalf("failed to parse hash range %v", err)
+	}
+	second, err := ParseHashRange("c0000000-ffffffff")
+	if err != nil {
+		t.Fatalf("failed to parse hash range %v", err)
+	}
+
+	values, err := query.Values(CollectionSplitShard{
+		Collection:    "events",
+		Shard:         "shard1",
+		Ranges:        []HashRange{first, second},
+		SplitMethod:   LinkSplit,
+		SplitFuzz:     0.1,
+		SplitByPrefix: true,
+		Properties:    CoreProperties{"dataDir": "/data"},
+		Async:         "split-1",
+	})
+	if err != nil {
+		t.Errorf("failed to encode split shard parameters %v", err)
+	}
+
+	expected := "async=split-1&collection=events&property.dataDir=%2Fdata&ranges=80000000-bfffffff%2Cc0000000-ffffffff" +
+		"&shard=shard1&splitByPrefix=true&splitFuzz=0.1&splitMethod=link"
+	if values.Encode() != expected {
+		t.Errorf("failed to encode split shard parameters %v", values.Encode())
+	}
+}
+
+func TestDeleteShardParameters(t *testing.T) {
+	keep := false
+	values, err := query.Values(CollectionDeleteShard{Collection: "events", Shard: "shard1", DeleteDataDir: &keep})
+	if err != nil {
+		t.Errorf("failed to encode delete shard parameters %v", err)
+	}
+
+	if values.Encode() != "collection=events&deleteDataDir=false&shard=shard1" {
+		t.Errorf("failed to encode delete shard parameters %v", values.Encode())
+	}
+}
+
+func TestShardActions(t *testing.T) {
+	var actions []string
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+		actions = append(actions, params.Get("action"))
+		if params.Get("collection") != "events" || params.Get("wt") != "json" {
+			t.Errorf("failed to send shard action %v", r.URL)
+		}
+		_, _ = w.Write([]byte(`{"responseHeader": {"status": 0}}`))
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	if _, err := client.Collection.SplitShard(ctx, CollectionSplitShard{Collection: "events", Shard: "shard1"}); err != nil {
+		t.Fatalf("failed to split shard %v", err)
+	}
+	if _, err := client.Collection.CreateShard(ctx, CollectionCreateShard{Collection: "events", Shard: "2020"}); err != nil {
+		t.Fatalf("failed to create shard %v", err)
+	}
+	if _, err := client.Collection.DeleteShard(ctx, CollectionDeleteShard{Collection: "events", Shard: "shard1"}); err != nil {
+		t.Fatalf("failed to delete shard %v", err)
+	}
+
+	if len(actions) != 3 || actions[0] != "SPLITSHARD" || actions[1] != "CREATESHARD" || actions[2] != "DELETESHARD" {
+		t.Errorf("failed to send shard actions %v", actions)
+	}
+}
@@ -0,0 +1,166 @@
+// https://lucene.apache.org/solr/guide/8_5/spatial-search.html
+package solr
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type SpatialPredicate string
+
+const (
+	SpatialIntersects   SpatialPredicate = "Intersects"
+	SpatialIsWithin     SpatialPredicate = "IsWithin"
+	SpatialContains     SpatialPredicate = "Contains"
+	SpatialIsDisjointTo SpatialPredicate = "IsDisjointTo"
+)
+
+// A point in latitude and longitude degrees, indexed and returned by Solr as "lat,lon".
+type LatLon struct {
+	Lat float64
+	Lon float64
+}
+
+type SpatialParameters struct {
+	// The spatial field used by geofilt, bbox and geodist() when not given explicitly.
+	Field string `url:"sfield,omitempty"`
+
+	// The center point used by geofilt, bbox and geodist() when not given explicitly.
+	Point *LatLon `url:"pt,omitempty"`
+
+	// The radial distance, usually in kilometers.
+	Distance float64 `url:"d,omitempty"`
+}
+
+type SpatialFilter struct {
+	// The spatial field to filter on.
+	Field string
+
+	// The center point of the filter.
+	Point LatLon
+
+	// The radial distance, usually in kilometers.
+	Distance float64
+
+	// Scores matching documents by distance, e.g. kilometers, miles or degrees.
+	Score string
+
+	// Set to false to not cache the filter, useful for large numbers of distinct points.
+	Cache string
+}
+
+// ParseLatLon parses a "lat,lon" point.
+func ParseLatLon(s string) (LatLon, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 2 {
+		return LatLon{}, fmt.Errorf("solr: invalid lat,lon point %q", s)
+	}
+
+	lat, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return LatLon{}, fmt.Errorf("solr: invalid latitude in %q: %v", s, err)
+	}
+
+	lon, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return LatLon{}, fmt.Errorf("solr: invalid longitude in %q: %v", s, err)
+	}
+
+	return LatLon{Lat: lat, Lon: lon}, nil
+}
+
+func (l LatLon) String() string {
+	return strconv.FormatFloat(l.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(l.Lon, 'f', -1, 64)
+}
+
+func (l LatLon) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+func (l *LatLon) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	point, err := ParseLatLon(s)
+	if err != nil {
+		return err
+	}
+	*l = point
+
+	return nil
+}
+
+func (l *LatLon) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, l.String())
+	return nil
+}
+
+// LatLon parses the "lat,lon" value of a spatial field of the document.
+func (d Doc) LatLon(field string) (LatLon, error) {
+	switch value := d[field].(type) {
+	case string:
+		return ParseLatLon(value)
+	case []interface{}:
+		if len(value) == 1 {
+			if s, ok := value[0].(string); ok {
+				return ParseLatLon(s)
+			}
+		}
+	case nil:
+		return LatLon{}, fmt.Errorf("solr: field %s not found", field)
+	}
+
+	return LatLon{}, fmt.Errorf("solr: field %s is not a lat,lon point", field)
+}
+
+// GeoFilt builds the {!geofilt} filter, matching documents within the distance of the point.
+func (s SpatialFilter) GeoFilt() string {
+	return s.localParams("geofilt")
+}
+
+// BBox builds the {!bbox} filter, matching documents within the bounding box of the circle
+// given by the point and distance. It is cheaper to compute than geofilt.
+func (s SpatialFilter) BBox() string {
+	return s.localParams("bbox")
+}
+
+func (s SpatialFilter) localParams(parser string) string {
+	return localParams(parser,
+		"sfield", s.Field,
+		"pt", s.Point.String(),
+		"d", strconv.FormatFloat(s.Distance, 'f', -1, 64),
+		"score", s.Score,
+		"cache", s.Cache,
+	)
+}
+
+// GeoDist builds the geodist() function for the field and point, usable as a sort clause,
+// e.g. GeoDist("store", pt) + " asc", or as a pseudo-field, e.g. "dist:" + GeoDist("store", pt).
+func GeoDist(field string, point LatLon) string {
+	return fmt.Sprintf("geodist(%s,%s)", field, point.String())
+}
+
+// Polygon builds a WKT polygon from its vertices, closing the ring when needed.
+func Polygon(points ...LatLon) string {
+	if len(points) > 0 && points[0] != points[len(points)-1] {
+		points = append(points[:len(points):len(points)], points[0])
+	}
+
+	vertices := make([]string, 0, len(points))
+	for _, p := range points {
+		vertices = append(vertices, strconv.FormatFloat(p.Lon, 'f', -1, 64)+" "+strconv.FormatFloat(p.Lat, 'f', -1, 64))
+	}
+
+	return "POLYGON((" + strings.Join(vertices, ", ") + "))"
+}
+
+// SpatialQuery builds a query on a RPT field for a WKT shape, e.g.
+// geo:"Intersects(POLYGON((-10 30, -40 40, -10 -20, 40 20, 0 0, -10 30)))".
+func SpatialQuery(field string, predicate SpatialPredicate, wkt string) string {
+	return fmt.Sprintf(`%s:"%s(%s)"`, field, predicate, wkt)
+}
@@ -0,0 +1,77 @@
+package solr
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestLatLon(t *testing.T) {
+	b, err := json.Marshal(Document{"id": "1", "store": LatLon{Lat: 45.15, Lon: -93.85}})
+	if err != nil {
+		t.Fatalf("failed to encode document %v", err)
+	}
+
+	if string(b) != `{"id":"1","store":"45.15,-93.85"}` {
+		t.Errorf("failed to encode document %v", string(b))
+	}
+
+	var response Response
+	err = json.Unmarshal([]byte(`{"response": {"numFound": 1, "start": 0, "docs": [{"id": "1", "store": "45.15,-93.85"}]}}`), &response)
+	if err != nil {
+		t.Fatalf("failed to decode response %v", err)
+	}
+
+	point, err := response.Response.Docs[0].LatLon("store")
+	if err != nil || point != (LatLon{Lat: 45.15, Lon: -93.85}) {
+		t.Errorf("failed to read point %v (%v)", point, err)
+	}
+
+	if _, err := response.Response.Docs[0].LatLon("id"); err == nil {
+		t.Error("failed to reject a non spatial field")
+	}
+}
+
+func TestSpatialFilter(t *testing.T) {
+	filter := SpatialFilter{Field: "store", Point: LatLon{Lat: 45.15, Lon: -93.85}, Distance: 5}
+
+	if filter.GeoFilt() != "{!geofilt sfield=store pt=45.15,-93.85 d=5}" {
+		t.Errorf("failed to build geofilt %v", filter.GeoFilt())
+	}
+
+	if filter.BBox() != "{!bbox sfield=store pt=45.15,-93.85 d=5}" {
+		t.Errorf("failed to build bbox %v", filter.BBox())
+	}
+
+	if GeoDist("store", filter.Point)+" asc" != "geodist(store,45.15,-93.85) asc" {
+		t.Errorf("failed to build geodist %v", GeoDist("store", filter.Point))
+	}
+}
+
+func TestSpatialQuery(t *testing.T) {
+	polygon := Polygon(LatLon{Lat: 30, Lon: -10}, LatLon{Lat: 40, Lon: -40}, LatLon{Lat: -20, Lon: -10})
+
+	q := SpatialQuery("geo", SpatialIntersects, polygon)
+	if q != `geo:"Intersects(POLYGON((-10 30, -40 40, -10 -20, -10 30)))"` {
+		t.Errorf("failed to build spatial query %v", q)
+	}
+}
+
+func TestSpatialParameters(t *testing.T) {
+	params, err := query.Values(SelectParameters{
+		Query: "*:*",
+		Sort:  "geodist() asc",
+		SpatialParameters: SpatialParameters{
+			Field: "store",
+			Point: &LatLon{Lat: 45.15, Lon: -93.85},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to encode parameters %v", err)
+	}
+
+	if params.Get("sfield") != "store" || params.Get("pt") != "45.15,-93.85" || params.Get("d") != "" {
+		t.Errorf("failed to encode parameters %v", params.Encode())
+	}
+}
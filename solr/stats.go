@@ -0,0 +1,193 @@
+// https://lucene.apache.org/solr/guide/8_5/the-stats-component.html
+package solr
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type StatsValueKind string
+
+const (
+	StatsValueNumeric StatsValueKind = "numeric"
+	StatsValueDate    StatsValueKind = "date"
+	StatsValueString  StatsValueKind = "string"
+)
+
+type StatsParameters struct {
+	// If true, then invokes the Stats component.
+	Enable bool `url:"stats,omitempty"`
+
+	// Specifies a field for which statistics should be generated. This parameter may be invoked
+	// multiple times in a query in order to request statistics on multiple fields. Local params
+	// may be used to choose which statistics are computed, see StatsField.
+	Field []string `url:"stats.field,omitempty"`
+
+	// Returns sub-results for values within the specified facet.
+	Facet []string `url:"stats.facet,omitempty"`
+
+	// If true, the countDistinct and distinctValues statistics will be computed and included
+	// in the response.
+	CalcDistinct bool `url:"stats.calcdistinct,omitempty"`
+}
+
+type StatsField struct {
+	// The field, or function query, for which statistics should be generated.
+	Field string
+
+	// Overrides the name of the field in the response.
+	Key string
+
+	// Tag used to refer to this stats field, e.g. from a facet pivot.
+	Tag string
+
+	// Excludes the filter queries tagged with the given tags.
+	ExcludeTags []string
+
+	Min            bool
+	Max            bool
+	Sum            bool
+	Count          bool
+	Missing        bool
+	SumOfSquares   bool
+	Mean           bool
+	Stddev         bool
+	CountDistinct  bool
+	DistinctValues bool
+
+	// A list of percentile values to compute using the t-digest algorithm, e.g. 50, 99.
+	Percentiles []float64
+
+	// Computes a statistical approximation of the number of distinct values using HyperLogLog.
+	Cardinality bool
+}
+
+type Stats struct {
+	StatsFields map[string]FieldStats `json:"stats_fields,omitempty"`
+}
+
+type FieldStats struct {
+	Min            StatsValue                       `json:"min,omitempty"`
+	Max            StatsValue                       `json:"max,omitempty"`
+	Count          int64                            `json:"count,omitempty"`
+	Missing        int64                            `json:"missing,omitempty"`
+	Sum            float64                          `json:"sum,omitempty"`
+	SumOfSquares   float64                          `json:"sumOfSquares,omitempty"`
+	Mean           StatsValue                       `json:"mean,omitempty"`
+	Stddev         float64                          `json:"stddev,omitempty"`
+	Percentiles    Percentiles                      `json:"percentiles,omitempty"`
+	Cardinality    int64                            `json:"cardinality,omitempty"`
+	CountDistinct  int64                            `json:"countDistinct,omitempty"`
+	DistinctValues []interface{}                    `json:"distinctValues,omitempty"`
+	Facets         map[string]map[string]FieldStats `json:"facets,omitempty"`
+}
+
+// A min, max or mean statistic. Numeric fields are decoded into Float, date fields
+// into Time, while String holds the raw value of date and string fields.
+type StatsValue struct {
+	Kind   StatsValueKind
+	Float  float64
+	Time   time.Time
+	String string
+}
+
+type Percentile struct {
+	Percentile float64
+	Value      float64
+}
+
+// Percentiles in the order they were requested.
+type Percentiles []Percentile
+
+// String builds the stats.field parameter value, prefixing the field with the local params
+// that select the statistics to compute, e.g. {!min=true max=true percentiles='50,99'}price.
+func (s StatsField) String() string {
+	percentiles := make([]string, 0, len(s.Percentiles))
+	for _, p := range s.Percentiles {
+		percentiles = append(percentiles, strconv.FormatFloat(p, 'f', -1, 64))
+	}
+
+	params := localParams("",
+		"key", s.Key,
+		"tag", s.Tag,
+		"ex", strings.Join(s.ExcludeTags, ","),
+		"min", formatBool(s.Min),
+		"max", formatBool(s.Max),
+		"sum", formatBool(s.Sum),
+		"count", formatBool(s.Count),
+		"missing", formatBool(s.Missing),
+		"sumOfSquares", formatBool(s.SumOfSquares),
+		"mean", formatBool(s.Mean),
+		"stddev", formatBool(s.Stddev),
+		"countDistinct", formatBool(s.CountDistinct),
+		"distinctValues", formatBool(s.DistinctValues),
+		"percentiles", strings.Join(percentiles, ","),
+		"cardinality", formatBool(s.Cardinality),
+	)
+	if params == "{!}" {
+		return s.Field
+	}
+
+	return params + s.Field
+}
+
+func (v *StatsValue) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		return nil
+	}
+
+	if err := json.Unmarshal(b, &v.Float); err == nil {
+		v.Kind = StatsValueNumeric
+		return nil
+	}
+
+	if err := json.Unmarshal(b, &v.String); err != nil {
+		return err
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, v.String); err == nil {
+		v.Kind = StatsValueDate
+		v.Time = t
+		return nil
+	}
+
+	v.Kind = StatsValueString
+
+	return nil
+}
+
+func (p *Percentiles) UnmarshalJSON(b []byte) error {
+	var pairs []json.RawMessage
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")) {
+		pairs, err = orderedPairs(b)
+	} else {
+		err = json.Unmarshal(b, &pairs)
+	}
+	if err != nil {
+		return err
+	}
+
+	*p = make(Percentiles, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		var key string
+		if err := json.Unmarshal(pairs[i], &key); err != nil {
+			return err
+		}
+
+		percentile := Percentile{}
+		if percentile.Percentile, err = strconv.ParseFloat(key, 64); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(pairs[i+1], &percentile.Value); err != nil {
+			return err
+		}
+
+		*p = append(*p, percentile)
+	}
+
+	return nil
+}
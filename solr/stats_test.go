@@ -0,0 +1,76 @@
+package solr
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStatsResponseDecode(t *testing.T) {
+	b := []byte(`{
+		"responseHeader": {"status": 0, "QTime": 5},
+		"stats": {
+			"stats_fields": {
+				"price": {
+					"min": 0.0, "max": 2199.0, "count": 5, "missing": 1, "sum": 2849.96,
+					"sumOfSquares": 4900000.5, "mean": 569.99, "stddev": 920.3,
+					"percentiles": ["50.0", 74.99, "99.0", 2199.0],
+					"cardinality": 5,
+					"facets": {"inStock": {"true": {"min": 11.5, "max": 2199.0, "count": 4, "missing": 0}}}
+				},
+				"manufacturedate_dt": {
+					"min": "2005-08-01T16:30:25Z", "max": "2006-02-13T15:26:37Z", "count": 3, "missing": 2,
+					"sum": 3.40296E12, "mean": "2005-11-30T09:51:47.333Z", "stddev": 8.4E9
+				},
+				"name_s": {"min": "Apple", "max": "Samsung", "count": 5, "missing": 0}
+			}
+		}
+	}`)
+
+	var response Response
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatalf("failed to decode stats response %v", err)
+	}
+
+	price := response.Stats.StatsFields["price"]
+	if price.Max.Kind != StatsValueNumeric || price.Max.Float != 2199 || price.Count != 5 || price.Missing != 1 || price.Cardinality != 5 {
+		t.Errorf("failed to decode numeric stats %v", price)
+	}
+
+	expected := Percentiles{{Percentile: 50, Value: 74.99}, {Percentile: 99, Value: 2199}}
+	if !reflect.DeepEqual(price.Percentiles, expected) {
+		t.Errorf("failed to decode percentiles %v", price.Percentiles)
+	}
+
+	if price.Facets["inStock"]["true"].Min.Float != 11.5 {
+		t.Errorf("failed to decode stats facets %v", price.Facets)
+	}
+
+	date := response.Stats.StatsFields["manufacturedate_dt"]
+	if date.Min.Kind != StatsValueDate || !date.Min.Time.Equal(time.Date(2005, 8, 1, 16, 30, 25, 0, time.UTC)) || date.Mean.Kind != StatsValueDate {
+		t.Errorf("failed to decode date stats %v", date)
+	}
+
+	name := response.Stats.StatsFields["name_s"]
+	if name.Min.Kind != StatsValueString || name.Min.String != "Apple" || name.Max.String != "Samsung" {
+		t.Errorf("failed to decode string stats %v", name)
+	}
+}
+
+func TestStatsField(t *testing.T) {
+	field := StatsField{
+		Field:       "price",
+		Min:         true,
+		Max:         true,
+		Percentiles: []float64{50, 99.9},
+	}.String()
+
+	if field != "{!min=true max=true percentiles=50,99.9}price" {
+		t.Errorf("failed to build stats field %v", field)
+	}
+
+	if (StatsField{Field: "price"}).String() != "price" {
+		t.Error("failed to build stats field without local params")
+	}
+}
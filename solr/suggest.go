@@ -0,0 +1,79 @@
+// https://lucene.apache.org/solr/guide/8_5/suggester.html
+package solr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type suggestBase struct {
+	Suggest bool `url:"suggest,omitempty"`
+	WT      WT   `url:"wt,omitempty"`
+}
+
+type SuggestRequest struct {
+	suggestBase
+
+	// The query to use for suggestion lookups. This parameter is required.
+	Query string `url:"suggest.q,omitempty"`
+
+	// The name of the dictionary component(s) configured in the search component. More than
+	// one dictionary can be requested, each of them is returned separately in the response.
+	Dictionary []string `url:"suggest.dictionary,omitempty"`
+
+	// Specifies the number of suggestions for Solr to return.
+	Count int `url:"suggest.count,omitempty"`
+
+	// A Context Filter Query used to filter suggestions based on the context field, if supported
+	// by the suggester.
+	ContextFilterQuery string `url:"suggest.cfq,omitempty"`
+
+	// If true, it will build the suggester index. This is likely useful only for initial requests;
+	// you would probably not want to build the dictionary on every request.
+	Build bool `url:"suggest.build,omitempty"`
+
+	// If true, it will reload the suggester index.
+	Reload bool `url:"suggest.reload,omitempty"`
+
+	// If true, it will build all suggester indexes.
+	BuildAll bool `url:"suggest.buildAll,omitempty"`
+
+	// If true, it will reload all suggester indexes.
+	ReloadAll bool `url:"suggest.reloadAll,omitempty"`
+}
+
+// Suggestions indexed by dictionary name and then by the suggest.q used for the lookup.
+type SuggestResponse map[string]map[string]SuggestResult
+
+type SuggestResult struct {
+	NumFound    int          `json:"numFound"`
+	Suggestions []Suggestion `json:"suggestions,omitempty"`
+}
+
+type Suggestion struct {
+	Term    string `json:"term,omitempty"`
+	Weight  int64  `json:"weight,omitempty"`
+	Payload string `json:"payload,omitempty"`
+}
+
+// SUGGEST: Suggestions for the query using the SuggestComponent
+// Results are returned per dictionary and then per query in Response.Suggest.
+func (d *DocumentAPI) Suggest(ctx context.Context, collection string, suggest SuggestRequest) (*Response, error) {
+	suggest.WT = JSON
+	suggest.Suggest = true
+
+	path := fmt.Sprintf("/solr/%s/suggest", collection)
+
+	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil, suggest, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := d.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
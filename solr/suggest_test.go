@@ -0,0 +1,37 @@
+package solr
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSuggestResponseDecode(t *testing.T) {
+	b := []byte(`{
+		"responseHeader": {"status": 0, "QTime": 2},
+		"suggest": {
+			"titleSuggester": {
+				"elec": {
+					"numFound": 2,
+					"suggestions": [
+						{"term": "electronics", "weight": 12, "payload": "cat"},
+						{"term": "electric guitar", "weight": 3, "payload": ""}
+					]
+				}
+			}
+		}
+	}`)
+
+	var response Response
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatalf("failed to decode suggest response %v", err)
+	}
+
+	result := response.Suggest["titleSuggester"]["elec"]
+	if result.NumFound != 2 || len(result.Suggestions) != 2 {
+		t.Fatalf("failed to decode suggest result %v", result)
+	}
+
+	if result.Suggestions[0].Term != "electronics" || result.Suggestions[0].Weight != 12 || result.Suggestions[0].Payload != "cat" {
+		t.Errorf("failed to decode suggestion %v", result.Suggestions[0])
+	}
+}
@@ -0,0 +1,201 @@
+// https://lucene.apache.org/solr/guide/8_5/the-terms-component.html
+package solr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type TermsSort string
+
+const (
+	TermsSortCount TermsSort = "count"
+	TermsSortIndex TermsSort = "index"
+)
+
+type termsBase struct {
+	Terms bool `url:"terms,omitempty"`
+	WT    WT   `url:"wt,omitempty"`
+}
+
+type TermsRequest struct {
+	termsBase
+
+	// Specifies the field from which to retrieve terms. This parameter is required and can
+	// be given multiple times.
+	Fields []string `url:"terms.fl,omitempty"`
+
+	// Restricts matches to terms that begin with the specified string.
+	Prefix string `url:"terms.prefix,omitempty"`
+
+	// Restricts matches to terms that match the regular expression.
+	Regex string `url:"terms.regex,omitempty"`
+
+	// Defines a Java regex flag to use when evaluating the regular expression defined with
+	// terms.regex, e.g. case_insensitive. The parameter can be given multiple times.
+	RegexFlag []string `url:"terms.regex.flag,omitempty"`
+
+	// Specifies the term to start at.
+	Lower string `url:"terms.lower,omitempty"`
+
+	// If false, excludes the lower bound term from the result set. The default is true.
+	LowerInclusive *bool `url:"terms.lower.incl,omitempty"`
+
+	// Specifies the term to stop at.
+	Upper string `url:"terms.upper,omitempty"`
+
+	// If true, the upper bound term is included in the result set. The default is false.
+	UpperInclusive *bool `url:"terms.upper.incl,omitempty"`
+
+	// Specifies the minimum document frequency to return in order for a term to be included.
+	MinCount int `url:"terms.mincount,omitempty"`
+
+	// Specifies the maximum document frequency a term must have in order to be included.
+	MaxCount int `url:"terms.maxcount,omitempty"`
+
+	// Specifies the maximum number of terms to return. The default is 10. If the limit is
+	// set to a number less than 0, then no maximum limit is enforced.
+	Limit int `url:"terms.limit,omitempty"`
+
+	// Defines how to sort the terms returned. Valid options are count, which sorts by the term
+	// frequency, with the highest term frequency first, or index, which sorts in index order.
+	Sort TermsSort `url:"terms.sort,omitempty"`
+
+	// If true, returns the raw characters of the indexed term, regardless of whether it is
+	// human-readable.
+	Raw bool `url:"terms.raw,omitempty"`
+
+	// Fetches the document frequency for the given list of terms, the terms are sent as a
+	// comma-separated list.
+	List []string `url:"terms.list,comma,omitempty"`
+
+	// If true, includes the index statistics (numDocs) in the response.
+	Stats bool `url:"terms.stats,omitempty"`
+
+	// If true, includes the total term frequency of each term along with its document frequency.
+	TotalTermFreq bool `url:"terms.ttf,omitempty"`
+}
+
+type TermCount struct {
+	Term string
+
+	// Document frequency of the term.
+	Count int64
+
+	// Total term frequency, only available when terms.ttf is requested.
+	TotalTermFreq int64
+}
+
+// Terms indexed by field name, each field holding the terms in the order returned by Solr.
+type TermsResponse map[string][]TermCount
+
+type IndexStats struct {
+	NumDocs int64 `json:"numDocs,omitempty"`
+}
+
+func (t *TermsResponse) UnmarshalJSON(b []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+
+	*t = make(TermsResponse, len(fields))
+	for field, raw := range fields {
+		var pairs []json.RawMessage
+		var err error
+		if bytes.HasPrefix(bytes.TrimSpace(raw), []byte("{")) {
+			pairs, err = orderedPairs(raw)
+		} else {
+			err = json.Unmarshal(raw, &pairs)
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(pairs)%2 != 0 {
+			return fmt.Errorf("solr: unexpected terms list for field %s", field)
+		}
+
+		counts := make([]TermCount, 0, len(pairs)/2)
+		for i := 0; i < len(pairs); i += 2 {
+			count := TermCount{}
+			if err := json.Unmarshal(pairs[i], &count.Term); err != nil {
+				return err
+			}
+
+			if bytes.HasPrefix(bytes.TrimSpace(pairs[i+1]), []byte("{")) {
+				var frequencies struct {
+					DF  int64 `json:"df"`
+					TTF int64 `json:"ttf"`
+				}
+				if err := json.Unmarshal(pairs[i+1], &frequencies); err != nil {
+					return err
+				}
+				count.Count = frequencies.DF
+				count.TotalTermFreq = frequencies.TTF
+			} else if err := json.Unmarshal(pairs[i+1], &count.Count); err != nil {
+				return err
+			}
+
+			counts = append(counts, count)
+		}
+		(*t)[field] = counts
+	}
+
+	return nil
+}
+
+// orderedPairs flattens a JSON object into key/value pairs keeping the order of the keys,
+// the same layout Solr uses for named lists when json.nl=flat.
+func orderedPairs(b []byte) ([]json.RawMessage, error) {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	var pairs []json.RawMessage
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+
+		pairs = append(pairs, encodedKey, value)
+	}
+
+	return pairs, nil
+}
+
+// TERMS: Indexed terms using the TermsComponent
+// Terms are returned per field in Response.Terms, index statistics in Response.IndexStats.
+func (d *DocumentAPI) Terms(ctx context.Context, collection string, terms TermsRequest) (*Response, error) {
+	terms.WT = JSON
+	terms.Terms = true
+
+	path := fmt.Sprintf("/solr/%s/terms", collection)
+
+	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil, terms, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := d.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
@@ -0,0 +1,56 @@
+package solr
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestTermsResponseDecode(t *testing.T) {
+	b := []byte(`{
+		"responseHeader": {"status": 0, "QTime": 0},
+		"indexstats": {"numDocs": 42},
+		"terms": {
+			"name": ["solr", 12, "search", 7, "apache", 7],
+			"cat": {"electronics": {"df": 3, "ttf": 5}, "books": {"df": 1, "ttf": 1}}
+		}
+	}`)
+
+	var response Response
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatalf("failed to decode terms response %v", err)
+	}
+
+	expected := []TermCount{{Term: "solr", Count: 12}, {Term: "search", Count: 7}, {Term: "apache", Count: 7}}
+	if !reflect.DeepEqual(response.Terms["name"], expected) {
+		t.Errorf("failed to decode name terms %v", response.Terms["name"])
+	}
+
+	expected = []TermCount{{Term: "electronics", Count: 3, TotalTermFreq: 5}, {Term: "books", Count: 1, TotalTermFreq: 1}}
+	if !reflect.DeepEqual(response.Terms["cat"], expected) {
+		t.Errorf("failed to decode cat terms %v", response.Terms["cat"])
+	}
+
+	if response.IndexStats.NumDocs != 42 {
+		t.Errorf("failed to decode index stats %v", response.IndexStats)
+	}
+}
+
+func TestTermsInclusiveParameters(t *testing.T) {
+	params, err := query.Values(TermsRequest{
+		Fields:         []string{"name"},
+		Lower:          "a",
+		LowerInclusive: Bool(false),
+		Upper:          "m",
+		UpperInclusive: Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("failed to encode terms parameters %v", err)
+	}
+
+	if params.Get("terms.lower.incl") != "false" || params.Get("terms.upper.incl") != "true" {
+		t.Errorf("failed to encode terms bounds %v", params.Encode())
+	}
+}
@@ -0,0 +1,218 @@
+package solr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// The interval between two status requests when none is given.
+const defaultPollInterval = time.Second
+
+// Returns nil once the replicas of the collection are in the expected state, otherwise an error
+// describing what is still missing.
+type ReplicaPredicate func(state ClusterState, collection string) error
+
+type WaitOptions struct {
+	// The number of active replicas on live nodes required in each active shard. By default
+	// every replica of the active shards has to be active.
+	ActiveReplicas int
+
+	// The maximum time to wait, in addition to the deadline of the context.
+	Timeout time.Duration
+
+	// The interval between two status requests. The default is one second.
+	PollInterval time.Duration
+}
+
+// A replica of an active shard that is not active on a live node yet.
+type PendingReplica struct {
+	Shard    string
+	Replica  string
+	NodeName string
+	State    ReplicaState
+	Live     bool
+	Leader   bool
+}
+
+// Returned when the cluster doesn't reach the expected state before the context is done.
+type WaitTimeoutError struct {
+	// The collection waited for, empty when waiting for another cluster state.
+	Collection string
+
+	// The reason the expected state was not reached at the last check.
+	Cause error
+
+	// The replicas of the collection still not active on a live node at the last check, e.g.
+	// recovering replicas.
+	Pending []PendingReplica
+
+	// The context error, context.DeadlineExceeded or context.Canceled.
+	Err error
+}
+
+func (e *WaitTimeoutError) Error() string {
+	return fmt.Sprintf("%v (%v)", e.Cause, e.Err)
+}
+
+func (e *WaitTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// ActiveReplicas returns a predicate satisfied when every active shard has an active leader and at
+// least n active replicas on live nodes, or when every replica is active on a live node if n is 0.
+func ActiveReplicas(n int) ReplicaPredicate {
+	return func(state ClusterState, name string) error {
+		if n <= 0 {
+			return state.CheckCollection(name)
+		}
+
+		collection, ok := state.Collections[name]
+		if !ok {
+			return &UnhealthyCollectionError{Collection: name, Problems: []string{"collection not found"}}
+		}
+
+		var problems []string
+		for _, shardName := range collection.ShardNames() {
+			shard := collection.Shards[shardName]
+			if shard.State != ShardActive {
+				continue
+			}
+
+			if _, leader, ok := shard.Leader(); !ok || leader.State != ReplicaActive || !state.IsLive(leader.NodeName) {
+				problems = append(problems, fmt.Sprintf("%s: no active leader", shardName))
+			}
+
+			active := 0
+			for _, replica := range shard.Replicas {
+				if replica.State == ReplicaActive && state.IsLive(replica.NodeName) {
+					active++
+				}
+			}
+			if active < n {
+				problems = append(problems, fmt.Sprintf("%s: %d of %d active replicas", shardName, active, n))
+			}
+		}
+
+		if len(problems) > 0 {
+			return &UnhealthyCollectionError{Collection: name, Problems: problems}
+		}
+
+		return nil
+	}
+}
+
+// PendingReplicas returns the replicas of the active shards of the collection that are not
+// active on a live node, sorted by shard and replica.
+func (c ClusterState) PendingReplicas(name string) []PendingReplica {
+	collection := c.Collections[name]
+
+	var pending []PendingReplica
+	for _, shardName := range collection.ShardNames() {
+		shard := collection.Shards[shardName]
+		if shard.State != ShardActive {
+			continue
+		}
+
+		for name, replica := range shard.Replicas {
+			live := c.IsLive(replica.NodeName)
+			if replica.State == ReplicaActive && live {
+				continue
+			}
+
+			pending = append(pending, PendingReplica{
+				Shard:    shardName,
+				Replica:  name,
+				NodeName: replica.NodeName,
+				State:    replica.State,
+				Live:     live,
+				Leader:   bool(replica.Leader),
+			})
+		}
+	}
+
+	sort.Slice(pending, func(i, j int) bool {
+		if pending[i].Shard != pending[j].Shard {
+			return pending[i].Shard < pending[j].Shard
+		}
+		return pending[i].Replica < pending[j].Replica
+	})
+
+	return pending
+}
+
+// WaitForCollectionActive: Wait for a Collection to be Active
+// The cluster status is requested until every active shard of the collection has an active leader
+// and the requested number of active replicas. A *WaitTimeoutError listing the pending replicas
+// is returned when the timeout or the context deadline is reached first.
+func (c *CollectionAPI) WaitForCollectionActive(ctx context.Context, name string, options WaitOptions) error {
+	return c.WaitForReplicas(ctx, name, ActiveReplicas(options.ActiveReplicas), options)
+}
+
+// WaitForReplicas: Wait for the Replicas of a Collection
+// The cluster status is requested every options.PollInterval until the predicate is satisfied,
+// options.ActiveReplicas is ignored. A *WaitTimeoutError listing the pending replicas is returned
+// when the timeout or the context deadline is reached first.
+func (c *CollectionAPI) WaitForReplicas(ctx context.Context, name string, predicate ReplicaPredicate, options WaitOptions) error {
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	return c.waitForReplicas(ctx, name, predicate, options.PollInterval)
+}
+
+func (c *CollectionAPI) waitForReplicas(ctx context.Context, name string, predicate ReplicaPredicate, pollInterval time.Duration) error {
+	var last ClusterState
+	err := c.waitForCluster(ctx, pollInterval, func(state ClusterState) error {
+		last = state
+		return predicate(state, name)
+	})
+
+	var timeoutErr *WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		timeoutErr.Collection = name
+		timeoutErr.Pending = last.PendingReplicas(name)
+	}
+
+	return err
+}
+
+// waitForCluster requests the cluster status every pollInterval until check returns nil. A
+// *WaitTimeoutError holding the last check error is returned when the context is done first.
+func (c *CollectionAPI) waitForCluster(ctx context.Context, pollInterval time.Duration, check func(state ClusterState) error) error {
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var last error
+	for {
+		response, err := c.ClusterStatus(ctx, CollectionClusterStatus{})
+		if err != nil {
+			if last != nil && ctx.Err() != nil {
+				return &WaitTimeoutError{Cause: last, Err: ctx.Err()}
+			}
+			return err
+		}
+		if err := response.Err(); err != nil {
+			return err
+		}
+
+		last = check(response.Cluster)
+		if last == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return &WaitTimeoutError{Cause: last, Err: ctx.Err()}
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package solr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// replicaState returns the test cluster state, the events replica on b:8983_solr being in the
+// given state.
+func replicaState(state ReplicaState) ClusterState {
+	cluster := testClusterState()
+	cluster.Collections["events"].Shards["shard1"].Replicas["core_node2"] = Replica{NodeName: "b:8983_solr", State: state}
+
+	return cluster
+}
+
+func TestActiveReplicas(t *testing.T) {
+	state := replicaState(ReplicaRecovering)
+
+	if err := ActiveReplicas(1)(state, "events"); err != nil {
+		t.Errorf("failed to accept one active replica %v", err)
+	}
+
+	err := ActiveReplicas(2)(state, "events")
+	var unhealthy *UnhealthyCollectionError
+	if !errors.As(err, &unhealthy) || !reflect.DeepEqual(unhealthy.Problems, []string{"shard1: 1 of 2 active replicas"}) {
+		t.Errorf("failed to require two active replicas %v", err)
+	}
+
+	if err := ActiveReplicas(0)(state, "events"); err == nil {
+		t.Error("failed to require every replica to be active")
+	}
+
+	expected := []PendingReplica{{Shard: "shard1", Replica: "core_node2", NodeName: "b:8983_solr", State: ReplicaRecovering, Live: true}}
+	if pending := state.PendingReplicas("events"); !reflect.DeepEqual(pending, expected) {
+		t.Errorf("failed to list pending replicas %+v", pending)
+	}
+}
+
+func TestWaitForCollectionActive(t *testing.T) {
+	var requests int32
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		state := ReplicaRecovering
+		if atomic.AddInt32(&requests, 1) > 2 {
+			state = ReplicaActive
+		}
+		writeClusterStatus(w, replicaState(state))
+	})
+	defer server.Close()
+
+	err := client.Collection.WaitForCollectionActive(context.Background(), "events", WaitOptions{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("failed to wait for collection active %v", err)
+	}
+	if atomic.LoadInt32(&requests) != 3 {
+		t.Errorf("failed to stop waiting once the collection is active %d", requests)
+	}
+}
+
+func TestWaitForReplicasTimeout(t *testing.T) {
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		writeClusterStatus(w, replicaState(ReplicaRecovering))
+	})
+	defer server.Close()
+
+	err := client.Collection.WaitForReplicas(context.Background(), "events", ActiveReplicas(0), WaitOptions{
+		Timeout:      50 * time.Millisecond,
+		PollInterval: time.Millisecond,
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("failed to stop waiting at the timeout %v", err)
+	}
+
+	var timeoutErr *WaitTimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("failed to report the wait timeout %v", err)
+	}
+	if timeoutErr.Collection != "events" || len(timeoutErr.Pending) != 1 || timeoutErr.Pending[0].Replica != "core_node2" {
+		t.Errorf("failed to report the pending replicas %+v", timeoutErr)
+	}
+}